@@ -17,6 +17,7 @@
 package v3
 
 import (
+	"strings"
 	"time"
 
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -48,6 +49,75 @@ type CSData struct {
 	StatusMonitoredServices string
 	ServiceNames            map[string][]string
 	UtilsImage              string
+	// ShadowMergeEnable writes the merged sizing into a shadow OperandConfig,
+	// which is promoted to the live one only after approval
+	ShadowMergeEnable bool
+	// PDBCheckEnable keeps the replicas shrunk on deletion from dropping below
+	// the minAvailable of the operand
+	PDBCheckEnable bool
+	// VerifyAfterWrite re-reads the OperandConfig after updating it, and logs
+	// or errors when the services differ from the merged ones
+	VerifyAfterWrite string
+	// MasterWinsEnable makes the master CommonService CR win the conflicts
+	// for the keys it sets, regardless of the larger values from other CRs
+	MasterWinsEnable bool
+	// NullDeleteEnable deletes the OperandConfig keys explicitly set to null
+	// in the CommonService CR
+	NullDeleteEnable bool
+	// CPUStripEventEnable records an event on the OperandConfig when the cpu
+	// limit is stripped for a non-default profile controller
+	CPUStripEventEnable bool
+	// OperandConfigName is the name of the OperandConfig in ServicesNs the
+	// CommonService CRs are merged into
+	OperandConfigName string
+	// ExtraProfileControllers are the independent profile controllers
+	// registered in addition to turbo, turbonomic and vpa
+	ExtraProfileControllers []string
+	// ProfileResetControllers are the non-default profile controllers which
+	// clean up the profile along with the sizing
+	ProfileResetControllers []string
+	// SumReplicasEnable sums the replicas and instances across the
+	// CommonService CRs instead of taking the largest
+	SumReplicasEnable bool
+	// AvgRoundingPolicy rounds the averaged resource quantities, one of
+	// ceil, floor and nearest. It defaults to ceil.
+	AvgRoundingPolicy string
+	// FilterByNamespace merges only the CommonService CRs in the watched
+	// namespaces, leaving out the CRs of the other operator instances
+	FilterByNamespace bool
+	// MaxMergeDepth bounds the nesting depth of the configs merged into the
+	// OperandConfig. It defaults to 100.
+	MaxMergeDepth int
+	// JSONPatchEnable writes the OperandConfig by a JSON patch of the changed
+	// paths of the services instead of a merge patch replacing all the services
+	JSONPatchEnable bool
+	// MergeProvenanceEnable logs the CommonService CR each merged value of
+	// the OperandConfig comes from
+	MergeProvenanceEnable bool
+	// OpreqRefreshEnable annotates the OperandRequests of the
+	// operators changed in the OperandConfig, so ODLM reprocesses them
+	// without waiting for its next resync
+	OpreqRefreshEnable bool
+	// SizingOverlayConfigMap is the name of the ConfigMap in the services
+	// namespace whose overlay is merged last on top of the aggregated sizing
+	SizingOverlayConfigMap string
+	// IncludeClonedCRs counts the CommonService CRs carrying the cloned-from
+	// label in the sizing, they are excluded by default
+	IncludeClonedCRs bool
+	// BulkMergeInterval coalesces the merges requested by a burst of the
+	// CommonService changes into one pass over all the CRs per interval, the
+	// CRs are merged one by one when it is 0
+	BulkMergeInterval time.Duration
+	// ListTimeout bounds listing the CommonService CRs for the merge, the
+	// default timeout applies when it is 0
+	ListTimeout time.Duration
+	// PruneOrphanedResources prunes the resources entries of the
+	// OperandConfig referencing the objects gone from the cluster
+	PruneOrphanedResources bool
+	// MergeWorkers is the number of the workers merging the operators of the
+	// OperandConfig concurrently, they are merged sequentially when it is 0
+	// or 1
+	MergeWorkers int
 }
 
 // +kubebuilder:pruning:PreserveUnknownFields
@@ -58,10 +128,24 @@ type ExtensionWithMarker struct {
 // +kubebuilder:pruning:PreserveUnknownFields
 type ServiceConfig struct {
 	Name string `json:"name"`
+	// Identity is the stable identity of the operator, which keeps the merged
+	// sizing when the operator is renamed. Default value is the name
+	// +optional
+	Identity string `json:"identity,omitempty"`
 	// +optional
 	Spec               map[string]ExtensionWithMarker `json:"spec"`
 	ManagementStrategy string                         `json:"managementStrategy,omitempty"`
-	Resources          []ExtensionWithMarker          `json:"resources,omitempty"`
+	// MergeStrategy is how the spec is merged into the OperandConfig, replace
+	// overwrites the OperandConfig spec of the operator. Default value is merge
+	// +kubebuilder:validation:Enum=merge;replace
+	// +optional
+	MergeStrategy string `json:"mergeStrategy,omitempty"`
+	// Profile pins the operator to the size profile, its sizing is expanded
+	// from the profile and is not aggregated with the other CRs
+	// +kubebuilder:validation:Enum=starterset;starter;small;medium;large;production
+	// +optional
+	Profile   string                `json:"profile,omitempty"`
+	Resources []ExtensionWithMarker `json:"resources,omitempty"`
 }
 
 // CommonServiceSpec defines the desired state of CommonService
@@ -255,6 +339,38 @@ type CommonServiceStatus struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Conditions",xDescriptors="urn:alm:descriptor:io.kubernetes.conditions"
 	Conditions []CommonServiceCondition `json:"conditions,omitempty"`
+	// EffectiveSizing summarizes the sizing merged into the OperandConfig from
+	// all the CommonService CRs, it is only set on the master CR
+	// +optional
+	EffectiveSizing []EffectiveSizing `json:"effectiveSizing,omitempty"`
+}
+
+// EffectiveSizing is the sizing of an operator merged into the OperandConfig
+type EffectiveSizing struct {
+	// Name is the name of the operator
+	Name string `json:"name"`
+	// CRs are the sizing of the CRs of the operator
+	CRs []EffectiveCRSizing `json:"crs,omitempty"`
+}
+
+// EffectiveCRSizing is the sizing of a CR in the OperandConfig
+type EffectiveCRSizing struct {
+	// Name is the name of the CR in the spec of the operator, e.g. mongoDB
+	Name string `json:"name"`
+	// +optional
+	Replicas *int64 `json:"replicas,omitempty"`
+	// +optional
+	Limits EffectiveQuantities `json:"limits,omitempty"`
+	// +optional
+	Requests EffectiveQuantities `json:"requests,omitempty"`
+}
+
+// EffectiveQuantities are the cpu and memory of the limits or requests
+type EffectiveQuantities struct {
+	// +optional
+	CPU string `json:"cpu,omitempty"`
+	// +optional
+	Memory string `json:"memory,omitempty"`
 }
 
 // CommonServiceCondition defines the observed condition of CommonService
@@ -301,6 +417,12 @@ const (
 	ConditionTypeError       ConditionType = "Error"
 	ConditionTypePending     ConditionType = "Pending"
 	ConditionTypeReconciling ConditionType = "Reconciling"
+	// ConditionTypeConfigMerged tells whether the configs of the CommonService
+	// CR are merged into the OperandConfig
+	ConditionTypeConfigMerged ConditionType = "ConfigMerged"
+	// ConditionTypeProfileControllerConflict tells the operators assigned to
+	// different non-default profile controllers by the CommonService CRs
+	ConditionTypeProfileControllerConflict ConditionType = "ProfileControllerConflict"
 )
 
 const (
@@ -310,6 +432,10 @@ const (
 	ConditionReasonWarning   = "WarningOccurred"
 	ConditionReasonError     = "ReconcileError"
 	ConditionReasonReady     = "ReconcileSucceeded"
+	ConditionReasonMerged    = "MergeSucceeded"
+	ConditionReasonMergeFail = "MergeFailed"
+	ConditionReasonMergeWait = "MergePending"
+	ConditionReasonConflict  = "ProfileControllerConflict"
 )
 
 const (
@@ -318,6 +444,8 @@ const (
 	ConditionMessageConfig    = "configuring CommonService CR."
 	ConditionMessageMissSC    = "warning: StorageClass is not configured in CommonService CR, if KeyCloak or IBM IM service will be deployed, please configure StorageClass in the CS CR. Refer to the documentation for more information: https://www.ibm.com/docs/en/cloud-paks/foundational-services/4.6?topic=options-configuring-foundational-services#storage-class"
 	ConditionMessageReady     = "CommonService CR is ready."
+	ConditionMessageMerged    = "configs of CommonService CR are merged into the OperandConfig."
+	ConditionMessageMergeWait = "configs of CommonService CR are waiting for the bulk merge into the OperandConfig."
 )
 
 // +kubebuilder:object:root=true
@@ -421,6 +549,58 @@ func (r *CommonService) SetErrorCondition(name string, ct ConditionType, cs core
 	r.setCondition(*c)
 }
 
+// SetConfigMergedCondition sets the ConfigMerged condition from the error of
+// merging the configs into the OperandConfig. There is only one ConfigMerged
+// condition, its transition time is kept while the status is unchanged.
+func (r *CommonService) SetConfigMergedCondition(err error) {
+	c := newCondition(ConditionTypeConfigMerged, corev1.ConditionTrue, ConditionReasonMerged, ConditionMessageMerged)
+	if err != nil {
+		c = newCondition(ConditionTypeConfigMerged, corev1.ConditionFalse, ConditionReasonMergeFail, err.Error())
+	}
+	r.setConfigMergedCondition(c)
+}
+
+// SetConfigMergePendingCondition sets the ConfigMerged condition to unknown
+// while the configs wait for the bulk merge
+func (r *CommonService) SetConfigMergePendingCondition() {
+	r.setConfigMergedCondition(newCondition(ConditionTypeConfigMerged, corev1.ConditionUnknown, ConditionReasonMergeWait, ConditionMessageMergeWait))
+}
+
+func (r *CommonService) setConfigMergedCondition(c *CommonServiceCondition) {
+	for i := range r.Status.Conditions {
+		if r.Status.Conditions[i].Type != ConditionTypeConfigMerged {
+			continue
+		}
+		if r.Status.Conditions[i].Status == c.Status {
+			c.LastTransitionTime = r.Status.Conditions[i].LastTransitionTime
+		}
+		r.Status.Conditions[i] = *c
+		return
+	}
+	r.Status.Conditions = append(r.Status.Conditions, *c)
+}
+
+// SetProfileControllerConflictCondition sets the ProfileControllerConflict
+// condition listing the conflicts, the condition is removed when there is none
+func (r *CommonService) SetProfileControllerConflictCondition(conflicts []string) {
+	for i := range r.Status.Conditions {
+		if r.Status.Conditions[i].Type != ConditionTypeProfileControllerConflict {
+			continue
+		}
+		if len(conflicts) == 0 {
+			r.Status.Conditions = append(r.Status.Conditions[:i], r.Status.Conditions[i+1:]...)
+			return
+		}
+		c := newCondition(ConditionTypeProfileControllerConflict, corev1.ConditionTrue, ConditionReasonConflict, strings.Join(conflicts, "; "))
+		c.LastTransitionTime = r.Status.Conditions[i].LastTransitionTime
+		r.Status.Conditions[i] = *c
+		return
+	}
+	if len(conflicts) > 0 {
+		r.Status.Conditions = append(r.Status.Conditions, *newCondition(ConditionTypeProfileControllerConflict, corev1.ConditionTrue, ConditionReasonConflict, strings.Join(conflicts, "; ")))
+	}
+}
+
 // UpdateConditionList updates the condition list of the CommonService CR
 func (r *CommonService) UpdateConditionList(ct corev1.ConditionStatus) {
 	// check all the conditions
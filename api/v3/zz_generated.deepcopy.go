@@ -88,6 +88,16 @@ func (in *CSData) DeepCopyInto(out *CSData) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.ExtraProfileControllers != nil {
+		in, out := &in.ExtraProfileControllers, &out.ExtraProfileControllers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProfileResetControllers != nil {
+		in, out := &in.ProfileResetControllers, &out.ProfileResetControllers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSData.
@@ -235,6 +245,13 @@ func (in *CommonServiceStatus) DeepCopyInto(out *CommonServiceStatus) {
 		*out = make([]CommonServiceCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.EffectiveSizing != nil {
+		in, out := &in.EffectiveSizing, &out.EffectiveSizing
+		*out = make([]EffectiveSizing, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommonServiceStatus.
@@ -282,6 +299,65 @@ func (in *ConfigurableCR) DeepCopy() *ConfigurableCR {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveCRSizing) DeepCopyInto(out *EffectiveCRSizing) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int64)
+		**out = **in
+	}
+	out.Limits = in.Limits
+	out.Requests = in.Requests
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveCRSizing.
+func (in *EffectiveCRSizing) DeepCopy() *EffectiveCRSizing {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveCRSizing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveQuantities) DeepCopyInto(out *EffectiveQuantities) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveQuantities.
+func (in *EffectiveQuantities) DeepCopy() *EffectiveQuantities {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveQuantities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveSizing) DeepCopyInto(out *EffectiveSizing) {
+	*out = *in
+	if in.CRs != nil {
+		in, out := &in.CRs, &out.CRs
+		*out = make([]EffectiveCRSizing, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveSizing.
+func (in *EffectiveSizing) DeepCopy() *EffectiveSizing {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveSizing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtensionWithMarker) DeepCopyInto(out *ExtensionWithMarker) {
 	*out = *in
@@ -51,6 +51,7 @@ import (
 	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/goroutines"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
 	commonservicewebhook "github.com/IBM/ibm-common-service-operator/v4/internal/controller/webhooks/commonservice"
 	operandrequestwebhook "github.com/IBM/ibm-common-service-operator/v4/internal/controller/webhooks/operandrequest"
 	// +kubebuilder:scaffold:imports
@@ -95,6 +96,11 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if err := controllers.ValidateConfigurationRules(rules.ConfigurationRules); err != nil {
+		klog.Errorf("Configuration rules are invalid: %v", err)
+		os.Exit(1)
+	}
+
 	watchNamespace := util.GetWatchNamespace()
 	gvkLabelMap := map[schema.GroupVersionKind]filteredcache.Selector{
 		corev1.SchemeGroupVersion.WithKind("ConfigMap"): {
@@ -167,6 +173,7 @@ func main() {
 			Bootstrap: bs,
 			Scheme:    mgr.GetScheme(),
 			Recorder:  mgr.GetEventRecorderFor("commonservice-controller"),
+			Log:       ctrl.Log.WithName("controllers").WithName("CommonService"),
 		}).SetupWithManager(mgr); err != nil {
 			klog.Errorf("Unable to create controller CommonService: %v", err)
 			os.Exit(1)
@@ -0,0 +1,128 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+// AggregationRuleKey overrides in the rules of an operator how its sizing is
+// aggregated across the CommonService CRs, "max" takes the largest request
+// and "min" takes the smallest one, so no CR over-requests a quota-bounded
+// operand
+const AggregationRuleKey = "aggregation"
+
+// getAggregationOverrides returns the aggregation extreme of the operators
+// overriding it in their rules
+func getAggregationOverrides(logger logr.Logger, ruleSlice []interface{}) map[string]Extreme {
+	overrides := map[string]Extreme{}
+	for _, rule := range ruleSlice {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := ruleMap["name"].(string)
+		if !ok {
+			continue
+		}
+		aggregation, ok := ruleMap[AggregationRuleKey]
+		if !ok {
+			continue
+		}
+		switch aggregation {
+		case string(Max):
+			overrides[name] = Max
+		case string(Min):
+			overrides[name] = Min
+		default:
+			logger.Info("Skipping the aggregation override, because it is neither max nor min", "operator", name, "aggregation", aggregation)
+		}
+	}
+	return overrides
+}
+
+// groupAggregationOverrides groups the operators by the extreme they are
+// summarized with, leaving out the ones aggregated like the other operators
+// by the extreme. The deletions shrink to the largest request of the remaining
+// CRs, which is already the max aggregation.
+func groupAggregationOverrides(overrides map[string]Extreme, extreme Extreme) map[Extreme]map[string]bool {
+	groups := map[Extreme]map[string]bool{}
+	for operator, override := range overrides {
+		var group Extreme
+		switch {
+		case override == Min:
+			group = Smallest
+		case override == Max && extreme != Max && extreme != Min:
+			group = Max
+		default:
+			continue
+		}
+		if groups[group] == nil {
+			groups[group] = map[string]bool{}
+		}
+		groups[group][operator] = true
+	}
+	return groups
+}
+
+// hasMinAggregation checks if any of the services is aggregated by the
+// smallest request
+func hasMinAggregation(services, ruleSlice []interface{}) bool {
+	overrides := getAggregationOverrides(logr.Discard(), ruleSlice)
+	for _, name := range serviceNames(services) {
+		if overrides[name] == Min {
+			return true
+		}
+	}
+	return false
+}
+
+// smallestCSConfigs summarizes the configs of all the CommonService CRs by the
+// smallest of each value set in spec. The values which can't be compared, and
+// the resources entries, keep the largest size.
+func smallestCSConfigs(logger logr.Logger, csConfigsList [][]interface{}, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, opconNs string) []interface{} {
+	return reduceCSConfigs(logger, csConfigsList, ruleSlice, serviceControllerMappingSummary, opconNs, smallestLeaves)
+}
+
+// smallestLeaves replaces the leaf values of the summary with the smallest of
+// the values set in the specs
+func smallestLeaves(summary map[string]interface{}, specs []map[string]interface{}) {
+	for key, value := range summary {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			var subSpecs []map[string]interface{}
+			for _, spec := range specs {
+				if subSpec, ok := spec[key].(map[string]interface{}); ok {
+					subSpecs = append(subSpecs, subSpec)
+				}
+			}
+			smallestLeaves(valueMap, subSpecs)
+			continue
+		}
+		if !isComparableLeaf(value) {
+			continue
+		}
+		smallest := value
+		for _, spec := range specs {
+			if specValue, ok := spec[key]; ok && isComparableLeaf(specValue) {
+				_, smallest = rules.ResourceComparison(smallest, specValue)
+			}
+		}
+		summary[key] = smallest
+	}
+}
@@ -0,0 +1,120 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("getExtremeizes with aggregation overrides", func() {
+	var (
+		r             *CommonServiceReconciler
+		ruleSlice     []interface{}
+		opconServices = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 3
+      resources:
+        limits:
+          memory: 2Gi
+`
+	)
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  aggregation: max
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+- name: ibm-test-operator
+  aggregation: min
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          memory: LARGEST_VALUE
+`)
+		tenantA := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 2
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        replicas: 2
+        resources:
+          limits:
+            memory: 2Gi
+`)
+		tenantB := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 4
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        replicas: 3
+        resources:
+          limits:
+            memory: 1Gi
+`)
+		r = newTestReconciler(tenantA, tenantB)
+	})
+
+	DescribeTable("should aggregate each operator by its override",
+		func(extreme Extreme) {
+			services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, extreme)
+			Expect(err).NotTo(HaveOccurred())
+			// The max aggregated operator takes the largest request, even when the
+			// replicas are summed for the others
+			mongoDB := getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+			Expect(mongoDB["replicas"]).To(BeEquivalentTo(4))
+			// The min aggregated operator takes the smallest request of each value
+			testCR := getItemByName(services, "ibm-test-operator").(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})
+			Expect(testCR["replicas"]).To(BeEquivalentTo(2))
+			memory, _, _ := unstructured.NestedFieldNoCopy(testCR, "resources", "limits", "memory")
+			Expect(memory).To(Equal("1Gi"))
+		},
+		Entry("merged by the largest value", Max),
+		Entry("merged by the sum", Sum),
+	)
+
+	It("should never skip recomputing the min aggregated operators on the deletion of a CR", func() {
+		Expect(hasMinAggregation(mustConvertStringToSlice(`
+- name: ibm-test-operator
+`), ruleSlice)).To(BeTrue())
+		Expect(hasMinAggregation(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+`), ruleSlice)).To(BeFalse())
+	})
+})
@@ -0,0 +1,198 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/mohae/deepcopy"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog"
+)
+
+const (
+	// RoundingCeil rounds the averaged quantities up, so the operands are
+	// never under-provisioned
+	RoundingCeil = "ceil"
+	// RoundingFloor rounds the averaged quantities down
+	RoundingFloor = "floor"
+	// RoundingNearest rounds the averaged quantities to the nearest, half up
+	RoundingNearest = "nearest"
+)
+
+var (
+	avgRoundingPolicyLock sync.RWMutex
+	avgRoundingPolicy     = RoundingCeil
+)
+
+// SetAvgRoundingPolicy sets how the averaged resource quantities are rounded
+// to millis, an empty or unknown policy falls back to ceil
+func SetAvgRoundingPolicy(policy string) {
+	switch policy {
+	case RoundingCeil, RoundingFloor, RoundingNearest:
+	case "":
+		policy = RoundingCeil
+	default:
+		klog.Warningf("Unknown rounding policy %s for the averaged quantities, using %s", policy, RoundingCeil)
+		policy = RoundingCeil
+	}
+	avgRoundingPolicyLock.Lock()
+	defer avgRoundingPolicyLock.Unlock()
+	avgRoundingPolicy = policy
+}
+
+func getAvgRoundingPolicy() string {
+	avgRoundingPolicyLock.RLock()
+	defer avgRoundingPolicyLock.RUnlock()
+	return avgRoundingPolicy
+}
+
+// divideMilli divides the sum of the milli values by count, rounding the
+// fraction of a milli by the policy
+func divideMilli(sum, count int64, policy string) int64 {
+	quotient, remainder := sum/count, sum%count
+	if remainder == 0 {
+		return quotient
+	}
+	switch policy {
+	case RoundingFloor:
+		return quotient
+	case RoundingNearest:
+		if 2*remainder >= count {
+			return quotient + 1
+		}
+		return quotient
+	}
+	return quotient + 1
+}
+
+// averageCSConfigs summarizes the configs of all the CommonService CRs by the
+// average of each cpu, memory and number value in spec. The values which
+// can't be averaged, and the resources entries, keep the largest size.
+func averageCSConfigs(logger logr.Logger, csConfigsList [][]interface{}, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, opconNs string) []interface{} {
+	return reduceCSConfigs(logger, csConfigsList, ruleSlice, serviceControllerMappingSummary, opconNs, averageLeaves)
+}
+
+// reduceCSConfigs summarizes the configs of all the CommonService CRs by the
+// largest size, then reduces the spec leaves of the summary from the specs of
+// all the CRs at once
+func reduceCSConfigs(logger logr.Logger, csConfigsList [][]interface{}, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, opconNs string, reduceLeaves func(map[string]interface{}, []map[string]interface{})) []interface{} {
+	var summaries [][]interface{}
+	var configSummary []interface{}
+	for _, csConfigs := range csConfigsList {
+		summary := mergeCSCRs(logger, nil, csConfigs, ruleSlice, serviceControllerMappingSummary, opconNs, nil)
+		summaries = append(summaries, summary)
+		configSummary = mergeCSCRs(logger, configSummary, deepcopy.Copy(summary).([]interface{}), ruleSlice, serviceControllerMappingSummary, opconNs, nil)
+	}
+
+	for _, service := range configSummary {
+		spec, ok := service.(map[string]interface{})["spec"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var specs []map[string]interface{}
+		for _, summary := range summaries {
+			summaryService := getItemByIdentity(summary, service)
+			if summaryService == nil {
+				continue
+			}
+			if summarySpec, ok := summaryService.(map[string]interface{})["spec"].(map[string]interface{}); ok {
+				specs = append(specs, summarySpec)
+			}
+		}
+		reduceLeaves(spec, specs)
+	}
+	return configSummary
+}
+
+// averageLeaves replaces the leaf values of the summary with the average of
+// the values set in the specs
+func averageLeaves(summary map[string]interface{}, specs []map[string]interface{}) {
+	for key, value := range summary {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			var subSpecs []map[string]interface{}
+			for _, spec := range specs {
+				if subSpec, ok := spec[key].(map[string]interface{}); ok {
+					subSpecs = append(subSpecs, subSpec)
+				}
+			}
+			averageLeaves(valueMap, subSpecs)
+			continue
+		}
+		var values []interface{}
+		for _, spec := range specs {
+			if specValue, ok := spec[key]; ok && specValue != nil {
+				values = append(values, specValue)
+			}
+		}
+		if avg, ok := averageValues(values); ok {
+			summary[key] = avg
+		}
+	}
+}
+
+// averageValues returns the average of the resource quantities or the
+// numbers. The average quantity is rounded to millis by the rounding policy,
+// the average number is rounded down.
+func averageValues(values []interface{}) (interface{}, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	switch values[0].(type) {
+	case string:
+		var sum int64
+		var format resource.Format
+		for i, value := range values {
+			str, ok := value.(string)
+			if !ok || strings.HasSuffix(str, "%") {
+				return nil, false
+			}
+			quantity, err := resource.ParseQuantity(str)
+			if err != nil {
+				return nil, false
+			}
+			if i == 0 {
+				format = quantity.Format
+			}
+			sum += quantity.MilliValue()
+		}
+		return resource.NewMilliQuantity(divideMilli(sum, int64(len(values)), getAvgRoundingPolicy()), format).String(), true
+	case float64, int64, int:
+		var sum float64
+		for _, value := range values {
+			switch value := value.(type) {
+			case float64:
+				sum += value
+			case int64:
+				sum += float64(value)
+			case int:
+				sum += float64(value)
+			default:
+				return nil, false
+			}
+		}
+		avg := float64(int64(sum / float64(len(values))))
+		if _, ok := values[0].(float64); ok {
+			return avg, true
+		}
+		return int64(avg), true
+	}
+	return nil, false
+}
@@ -0,0 +1,100 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Avg extreme", func() {
+	It("should merge the configs of the CRs by their average", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+		csConfig := func(replicas int, cpu, memory string) []interface{} {
+			return mustConvertStringToSlice(fmt.Sprintf(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: %d
+      resources:
+        limits:
+          cpu: %s
+          memory: %s
+`, replicas, cpu, memory))
+		}
+		opconServices := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 1000m
+          memory: 4Gi
+`)
+
+		services := mustMergeConfigs(opconServices, [][]interface{}{
+			csConfig(1, "100m", "1Gi"),
+			csConfig(2, "200m", "2Gi"),
+			csConfig(4, "600m", "3Gi"),
+		}, ruleSlice, map[string]string{"profileController": "default"}, Avg, testServicesNs)
+
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 300m
+          memory: 2Gi
+`)))
+	})
+})
+
+var _ = Describe("averageValues", func() {
+	AfterEach(func() {
+		SetAvgRoundingPolicy("")
+	})
+
+	DescribeTable("should round the average by the rounding policy",
+		func(policy string, values []interface{}, expected string) {
+			SetAvgRoundingPolicy(policy)
+			avg, ok := averageValues(values)
+			Expect(ok).To(BeTrue())
+			Expect(avg).To(Equal(expected))
+		},
+		Entry("ceil by default", "", []interface{}{"100m", "101m"}, "101m"),
+		Entry("floor", RoundingFloor, []interface{}{"100m", "101m"}, "100m"),
+		Entry("nearest half up", RoundingNearest, []interface{}{"100m", "101m"}, "101m"),
+		Entry("nearest down", RoundingNearest, []interface{}{"100m", "100m", "101m"}, "100m"),
+		Entry("ceil for an unknown policy", "truncate", []interface{}{"100m", "333m"}, "217m"),
+		Entry("exact average", "", []interface{}{"1Gi", "3Gi"}, "2Gi"),
+	)
+})
@@ -121,6 +121,28 @@ func NewNonOLMBootstrap(mgr manager.Manager) (bs *Bootstrap, err error) {
 		StatusMonitoredServices: constant.StatusMonitoredServices,
 		ServiceNames:            constant.ServiceNames,
 		UtilsImage:              util.GetUtilsImage(),
+		ShadowMergeEnable:       util.GetShadowMergeMode(),
+		PDBCheckEnable:          util.GetPDBCheckMode(),
+		VerifyAfterWrite:        util.GetVerifyAfterWriteMode(),
+		MasterWinsEnable:        util.GetMasterWinsMode(),
+		NullDeleteEnable:        util.GetNullDeleteMode(),
+		CPUStripEventEnable:     util.GetCPUStripEventMode(),
+		OperandConfigName:       util.GetOperandConfigName(),
+		ExtraProfileControllers: util.GetNonDefaultProfileControllers(),
+		ProfileResetControllers: util.GetProfileResetControllers(),
+		SumReplicasEnable:       util.GetSumReplicasMode(),
+		AvgRoundingPolicy:       util.GetAvgRoundingPolicy(),
+		FilterByNamespace:       util.GetFilterByNamespaceMode(),
+		MaxMergeDepth:           util.GetMaxMergeDepth(),
+		JSONPatchEnable:         util.GetJSONPatchMode(),
+		MergeProvenanceEnable:   util.GetMergeProvenanceMode(),
+		OpreqRefreshEnable:      util.GetOpreqRefreshMode(),
+		SizingOverlayConfigMap:  util.GetSizingOverlayConfigMap(),
+		IncludeClonedCRs:        util.GetIncludeClonedMode(),
+		BulkMergeInterval:       util.GetBulkMergeInterval(),
+		ListTimeout:             util.GetListTimeout(),
+		PruneOrphanedResources:  util.GetPruneOrphanedResourcesMode(),
+		MergeWorkers:            util.GetMergeWorkers(),
 	}
 
 	bs = &Bootstrap{
@@ -167,6 +189,28 @@ func NewBootstrap(mgr manager.Manager) (bs *Bootstrap, err error) {
 		StatusMonitoredServices: constant.StatusMonitoredServices,
 		ServiceNames:            constant.ServiceNames,
 		UtilsImage:              util.GetUtilsImage(),
+		ShadowMergeEnable:       util.GetShadowMergeMode(),
+		PDBCheckEnable:          util.GetPDBCheckMode(),
+		VerifyAfterWrite:        util.GetVerifyAfterWriteMode(),
+		MasterWinsEnable:        util.GetMasterWinsMode(),
+		NullDeleteEnable:        util.GetNullDeleteMode(),
+		CPUStripEventEnable:     util.GetCPUStripEventMode(),
+		OperandConfigName:       util.GetOperandConfigName(),
+		ExtraProfileControllers: util.GetNonDefaultProfileControllers(),
+		ProfileResetControllers: util.GetProfileResetControllers(),
+		SumReplicasEnable:       util.GetSumReplicasMode(),
+		AvgRoundingPolicy:       util.GetAvgRoundingPolicy(),
+		FilterByNamespace:       util.GetFilterByNamespaceMode(),
+		MaxMergeDepth:           util.GetMaxMergeDepth(),
+		JSONPatchEnable:         util.GetJSONPatchMode(),
+		MergeProvenanceEnable:   util.GetMergeProvenanceMode(),
+		OpreqRefreshEnable:      util.GetOpreqRefreshMode(),
+		SizingOverlayConfigMap:  util.GetSizingOverlayConfigMap(),
+		IncludeClonedCRs:        util.GetIncludeClonedMode(),
+		BulkMergeInterval:       util.GetBulkMergeInterval(),
+		ListTimeout:             util.GetListTimeout(),
+		PruneOrphanedResources:  util.GetPruneOrphanedResourcesMode(),
+		MergeWorkers:            util.GetMergeWorkers(),
 	}
 
 	bs = &Bootstrap{
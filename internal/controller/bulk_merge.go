@@ -0,0 +1,201 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// bulkMergeTrigger coalesces the merges requested by a burst of the
+// CommonService changes. Each merge pass reads all the CRs, so the requests
+// pending a pass are served by the same pass, and the passes run at most once
+// per interval.
+type bulkMergeTrigger struct {
+	// trigger holds at most one pending request, the others are dropped
+	trigger  chan struct{}
+	interval time.Duration
+
+	mu sync.Mutex
+	// pending holds the latest configs of each CR waiting for the next pass
+	pending map[types.NamespacedName]commonServiceConfigs
+	// results holds the configs of each CR merged by the last pass serving
+	// the CR, and the result of the pass
+	results map[types.NamespacedName]bulkMergeResult
+	// events enqueues the CRs served by a pass, so their ConfigMerged
+	// condition is set from the result. It is nil when nothing watches it.
+	events chan event.GenericEvent
+}
+
+type bulkMergeResult struct {
+	configs commonServiceConfigs
+	err     error
+}
+
+func newBulkMergeTrigger(interval time.Duration) *bulkMergeTrigger {
+	return &bulkMergeTrigger{
+		trigger:  make(chan struct{}, 1),
+		interval: interval,
+		pending:  map[types.NamespacedName]commonServiceConfigs{},
+		results:  map[types.NamespacedName]bulkMergeResult{},
+	}
+}
+
+// Trigger requests a merge pass without blocking
+func (t *bulkMergeTrigger) Trigger() {
+	select {
+	case t.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Request queues the configs of the CR for the next pass and triggers it
+func (t *bulkMergeTrigger) Request(key types.NamespacedName, configs commonServiceConfigs) {
+	t.mu.Lock()
+	t.pending[key] = commonServiceConfigs{configs: copyConfigs(configs.configs), mapping: configs.mapping}
+	t.mu.Unlock()
+	t.Trigger()
+}
+
+// Result returns the result of the pass which merged the configs of the CR,
+// it returns false while the configs are not merged by any pass yet
+func (t *bulkMergeTrigger) Result(key types.NamespacedName, configs commonServiceConfigs) (bool, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.pending[key]; ok {
+		return false, nil
+	}
+	result, ok := t.results[key]
+	if !ok || !reflect.DeepEqual(result.configs, configs) {
+		return false, nil
+	}
+	return true, result.err
+}
+
+// Forget drops the configs and the result of the deleted CR
+func (t *bulkMergeTrigger) Forget(key types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.pending, key)
+	delete(t.results, key)
+}
+
+// takePending returns the keys and the configs of the CRs waiting for the
+// pass in the order of the keys
+func (t *bulkMergeTrigger) takePending() ([]types.NamespacedName, []commonServiceConfigs) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	keys := make([]types.NamespacedName, 0, len(t.pending))
+	for key := range t.pending {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	configsList := make([]commonServiceConfigs, len(keys))
+	for i, key := range keys {
+		configsList[i] = t.pending[key]
+	}
+	t.pending = map[types.NamespacedName]commonServiceConfigs{}
+	return keys, configsList
+}
+
+// recordResults records the result of the pass for the CRs it served. The
+// configs of the failed pass are queued again for the retry, unless the CR
+// has requested newer ones meanwhile.
+func (t *bulkMergeTrigger) recordResults(keys []types.NamespacedName, configsList []commonServiceConfigs, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, key := range keys {
+		t.results[key] = bulkMergeResult{configs: configsList[i], err: err}
+		if _, ok := t.pending[key]; err != nil && !ok {
+			t.pending[key] = configsList[i]
+		}
+	}
+}
+
+// run runs the merge pass for the triggers until the ctx is done. The pass
+// waits the interval after the first trigger, so the triggers of the burst
+// are coalesced into it. A failed pass is triggered again.
+func (t *bulkMergeTrigger) run(ctx context.Context, merge func(context.Context, []commonServiceConfigs) error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.trigger:
+		}
+		timer := time.NewTimer(t.interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		// The triggers during the wait are served by this pass
+		select {
+		case <-t.trigger:
+		default:
+		}
+		keys, configsList := t.takePending()
+		err := merge(ctx, configsList)
+		t.recordResults(keys, configsList, err)
+		if err != nil {
+			klog.Errorf("Failed to merge the CommonService CRs into the OperandConfig, retrying: %v", err)
+			t.Trigger()
+		}
+		if t.events == nil {
+			continue
+		}
+		for _, key := range keys {
+			cs := &apiv3.CommonService{}
+			cs.SetName(key.Name)
+			cs.SetNamespace(key.Namespace)
+			select {
+			case t.events <- event.GenericEvent{Object: cs}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// ReconcileAll merges all the CommonService CRs into the OperandConfig in one
+// pass, the merge raises the sizing requested by the CRs and the full
+// recompute shrinks the sizing no CR requests anymore
+func (r *CommonServiceReconciler) ReconcileAll(ctx context.Context) error {
+	return r.reconcileAll(ctx, nil)
+}
+
+// reconcileAll merges the configs of the CRs served by the pass, in their
+// order, along with all the CommonService CRs. The OperandConfig is updated at
+// most once by the pass.
+func (r *CommonServiceReconciler) reconcileAll(ctx context.Context, configsList []commonServiceConfigs) error {
+	if _, _, _, err := r.mergeOperandConfigs(ctx, configsList, false, true); err != nil {
+		return err
+	}
+	r.refreshEffectiveSizing(ctx, nil)
+	return nil
+}
@@ -0,0 +1,190 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("bulk merge of the CommonService changes", func() {
+	var (
+		r       *CommonServiceReconciler
+		writes  *int
+		cancel  context.CancelFunc
+		done    chan struct{}
+		mapping = map[string]string{"profileController": "default"}
+	)
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`)))
+		writes = countOperandConfigWrites(r)
+		r.bulkMerge = newBulkMergeTrigger(200 * time.Millisecond)
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.TODO())
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			r.bulkMerge.run(ctx, r.reconcileAll)
+		}()
+	})
+
+	AfterEach(func() {
+		cancel()
+		<-done
+	})
+
+	It("should coalesce a burst of the CR changes into one pass", func() {
+		By("changing the CRs, each growing the replicas")
+		var crs []*apiv3.CommonService
+		for i, tenant := range []string{"tenant-a", "tenant-b", "tenant-c"} {
+			cs := newTestCommonServiceObject(tenant, "example-service", fmt.Sprintf(`
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: %d
+`, i+2))
+			Expect(r.Client.Create(context.TODO(), cs)).To(Succeed())
+			isEqual, err := r.updateOperandConfigWithCondition(context.TODO(), cs, nil, mapping)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isEqual).To(BeFalse())
+			expectConfigMergedCondition(cs, corev1.ConditionUnknown, apiv3.ConditionReasonMergeWait)
+			crs = append(crs, cs)
+		}
+
+		By("merging the burst in one pass")
+		time.Sleep(700 * time.Millisecond)
+		cancel()
+		<-done
+		Expect(*writes).To(Equal(1))
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(4))
+
+		By("serving the result to the CRs of the pass without another pass")
+		for _, cs := range crs {
+			_, err := r.updateOperandConfigWithCondition(context.TODO(), cs, nil, mapping)
+			Expect(err).NotTo(HaveOccurred())
+			expectConfigMergedCondition(cs, corev1.ConditionTrue, apiv3.ConditionReasonMerged)
+		}
+		Expect(r.bulkMerge.trigger).NotTo(Receive(), "the merged CRs triggered another pass")
+
+		By("waiting for the next pass with the changed configs of a CR")
+		_, err := r.updateOperandConfigWithCondition(context.TODO(), crs[0], []interface{}{map[string]interface{}{"name": "ibm-im-mongodb-operator"}}, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		expectConfigMergedCondition(crs[0], corev1.ConditionUnknown, apiv3.ConditionReasonMergeWait)
+	})
+})
+
+var _ = Describe("ReconcileAll", func() {
+	It("should update the OperandConfig once", func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 5
+      resources:
+        limits:
+          cpu: 100m
+`))
+		cs := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 2
+        resources:
+          limits:
+            cpu: "1"
+`)
+		r := newTestReconciler(opcon, cs)
+		writes := countOperandConfigWrites(r)
+
+		// The pass raises the cpu and shrinks the replicas in the same update
+		Expect(r.ReconcileAll(context.TODO())).To(Succeed())
+		Expect(*writes).To(Equal(1))
+		spec := getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")
+		Expect(spec["replicas"]).To(BeEquivalentTo(2))
+		Expect(spec["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"]).To(Equal("1"))
+	})
+})
+
+var _ = Describe("bulkMergeTrigger", func() {
+	It("should merge the configs of a failed pass again", func() {
+		trigger := newBulkMergeTrigger(time.Millisecond)
+		trigger.events = make(chan event.GenericEvent, 1)
+		key := types.NamespacedName{Namespace: "tenant-a", Name: "example-service"}
+		configs := commonServiceConfigs{mapping: map[string]string{"profileController": "default"}}
+		trigger.Request(key, configs)
+
+		ctx, cancel := context.WithCancel(context.TODO())
+		defer cancel()
+		passes := make(chan []commonServiceConfigs, 2)
+		failed := false
+		go trigger.run(ctx, func(ctx context.Context, configsList []commonServiceConfigs) error {
+			passes <- configsList
+			if !failed {
+				failed = true
+				return fmt.Errorf("conflict")
+			}
+			return nil
+		})
+
+		By("reporting the error of the failed pass")
+		Expect(<-passes).To(Equal([]commonServiceConfigs{configs}))
+		ev := <-trigger.events
+		Expect(ev.Object.GetName()).To(Equal(key.Name))
+		Expect(ev.Object.GetNamespace()).To(Equal(key.Namespace))
+
+		By("merging the configs again by the retry")
+		Expect(<-passes).To(Equal([]commonServiceConfigs{configs}))
+		<-trigger.events
+		merged, err := trigger.Result(key, configs)
+		Expect(merged).To(BeTrue())
+		Expect(err).NotTo(HaveOccurred())
+
+		By("forgetting the result of the CR")
+		trigger.Forget(key)
+		merged, _ = trigger.Result(key, configs)
+		Expect(merged).To(BeFalse())
+	})
+})
+
+// expectConfigMergedCondition checks the ConfigMerged condition of the CR
+func expectConfigMergedCondition(cs *apiv3.CommonService, status corev1.ConditionStatus, reason string) {
+	for _, c := range cs.Status.Conditions {
+		if c.Type == apiv3.ConditionTypeConfigMerged {
+			ExpectWithOffset(1, c.Status).To(Equal(status))
+			ExpectWithOffset(1, c.Reason).To(Equal(reason))
+			return
+		}
+	}
+	Fail(fmt.Sprintf("CommonService %s/%s has no ConfigMerged condition", cs.Namespace, cs.Name), 1)
+}
@@ -289,6 +289,217 @@ func GetUtilsImage() string {
 	return image
 }
 
+// GetShadowMergeMode returns whether the merged sizing is written into a shadow OperandConfig first
+func GetShadowMergeMode() bool {
+	isEnable, found := os.LookupEnv("SHADOW_MERGE_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetPDBCheckMode returns whether the shrunk replicas are checked against the minAvailable of the operand
+func GetPDBCheckMode() bool {
+	isEnable, found := os.LookupEnv("PDB_CHECK_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetVerifyAfterWriteMode returns how a mismatch found by re-reading the written OperandConfig is reported, "log" or "error"
+func GetVerifyAfterWriteMode() string {
+	mode, found := os.LookupEnv("VERIFY_AFTER_WRITE_MODE")
+	if !found {
+		return ""
+	}
+	return mode
+}
+
+// GetMasterWinsMode returns whether the master CommonService CR wins the sizing conflicts for the keys it sets
+func GetMasterWinsMode() bool {
+	isEnable, found := os.LookupEnv("MASTER_WINS_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetNullDeleteMode returns whether the keys set to null in the CommonService CR are deleted from the OperandConfig
+func GetNullDeleteMode() bool {
+	isEnable, found := os.LookupEnv("NULL_DELETE_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetCPUStripEventMode returns whether an event is recorded when the cpu limit is stripped for a non-default profile controller
+func GetCPUStripEventMode() bool {
+	isEnable, found := os.LookupEnv("CPU_STRIP_EVENT_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetOperandConfigName returns the name of the OperandConfig the CommonService
+// CRs are merged into, it defaults to common-service when OPERANDCONFIG_NAME is
+// unset or empty
+func GetOperandConfigName() string {
+	name := strings.TrimSpace(os.Getenv("OPERANDCONFIG_NAME"))
+	if name == "" {
+		return "common-service"
+	}
+	return name
+}
+
+// GetNonDefaultProfileControllers returns the independent profile controllers
+// registered in addition to the built-in ones, e.g. "keda,custom-vpa"
+func GetNonDefaultProfileControllers() []string {
+	var controllers []string
+	for _, controller := range strings.Split(os.Getenv("NON_DEFAULT_PROFILE_CONTROLLERS"), ",") {
+		if controller = strings.TrimSpace(controller); controller != "" {
+			controllers = append(controllers, controller)
+		}
+	}
+	return controllers
+}
+
+// GetProfileResetControllers returns the non-default profile controllers
+// which want the profile cleaned up along with the sizing, e.g. "vpa,keda"
+func GetProfileResetControllers() []string {
+	var controllers []string
+	for _, controller := range strings.Split(os.Getenv("PROFILE_RESET_CONTROLLERS"), ",") {
+		if controller = strings.TrimSpace(controller); controller != "" {
+			controllers = append(controllers, controller)
+		}
+	}
+	return controllers
+}
+
+// GetSumReplicasMode returns whether the replicas are summed across the
+// CommonService CRs instead of taking the largest
+func GetSumReplicasMode() bool {
+	isEnable, found := os.LookupEnv("SUM_REPLICAS_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetAvgRoundingPolicy returns how the averaged resource quantities are
+// rounded, one of ceil, floor and nearest
+func GetAvgRoundingPolicy() string {
+	return os.Getenv("AVG_ROUNDING_POLICY")
+}
+
+// GetFilterByNamespaceMode returns whether only the CommonService CRs in the
+// watched namespaces are merged into the OperandConfig
+func GetFilterByNamespaceMode() bool {
+	isEnable, found := os.LookupEnv("FILTER_BY_NAMESPACE_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetJSONPatchMode returns whether the OperandConfig is written by a JSON
+// patch of the changed paths instead of replacing all the services
+func GetJSONPatchMode() bool {
+	isEnable, found := os.LookupEnv("JSON_PATCH_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetMergeProvenanceMode returns whether the CommonService CR each merged
+// value comes from is logged
+func GetMergeProvenanceMode() bool {
+	isEnable, found := os.LookupEnv("MERGE_PROVENANCE_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetOpreqRefreshMode returns whether the OperandRequests of the
+// changed operators are refreshed after the OperandConfig is updated
+func GetOpreqRefreshMode() bool {
+	isEnable, found := os.LookupEnv("OPERANDREQUEST_REFRESH_MODE")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetIncludeClonedMode returns whether the CommonService CRs cloned from
+// another CR are counted in the sizing
+func GetIncludeClonedMode() bool {
+	isEnable, found := os.LookupEnv("INCLUDE_CLONED_COMMONSERVICES")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetBulkMergeInterval returns the interval coalescing the merges of the
+// CommonService CRs into one pass, 0 when it is not set or invalid
+func GetBulkMergeInterval() time.Duration {
+	interval, err := time.ParseDuration(os.Getenv("BULK_MERGE_INTERVAL"))
+	if err != nil || interval < 0 {
+		return 0
+	}
+	return interval
+}
+
+// GetListTimeout returns the timeout of listing the CommonService CRs, 0 when
+// it is not set or invalid
+func GetListTimeout() time.Duration {
+	timeout, err := time.ParseDuration(os.Getenv("LIST_COMMONSERVICES_TIMEOUT"))
+	if err != nil || timeout < 0 {
+		return 0
+	}
+	return timeout
+}
+
+// GetPruneOrphanedResourcesMode returns whether the resources entries of the
+// OperandConfig referencing the objects gone from the cluster are pruned
+func GetPruneOrphanedResourcesMode() bool {
+	isEnable, found := os.LookupEnv("PRUNE_ORPHANED_RESOURCES")
+	if found && isEnable == "true" {
+		return true
+	}
+	return false
+}
+
+// GetSizingOverlayConfigMap returns the name of the ConfigMap holding the
+// sizing overlay, empty when there is no overlay
+func GetSizingOverlayConfigMap() string {
+	return os.Getenv("SIZING_OVERLAY_CONFIGMAP")
+}
+
+// GetMaxMergeDepth returns the maximum nesting depth of the configs merged
+// into the OperandConfig, 0 when it is not set or invalid
+func GetMaxMergeDepth() int {
+	depth, err := strconv.Atoi(os.Getenv("MAX_MERGE_DEPTH"))
+	if err != nil || depth < 0 {
+		return 0
+	}
+	return depth
+}
+
+// GetMergeWorkers returns the number of the workers merging the operators of
+// the OperandConfig concurrently, 0 when it is not set or invalid
+func GetMergeWorkers() int {
+	workers, err := strconv.Atoi(os.Getenv("MERGE_WORKERS"))
+	if err != nil || workers < 0 {
+		return 0
+	}
+	return workers
+}
+
 // GetNSSCMSynchronization returns whether NSS ConfigMap shchronization with OperatorGroup is enabled
 func GetNSSCMSynchronization() bool {
 	isEnable, found := os.LookupEnv("NSSCM_SYNC_MODE")
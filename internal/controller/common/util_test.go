@@ -70,3 +70,14 @@ func TestSanitizeData(t *testing.T) {
 	result4 := SanitizeData(data4, "other", false)
 	assert.Equal(t, expectedResult4, result4)
 }
+
+func TestGetOperandConfigName(t *testing.T) {
+	// The empty and the blank names fall back to the default name
+	t.Setenv("OPERANDCONFIG_NAME", "")
+	assert.Equal(t, "common-service", GetOperandConfigName())
+	t.Setenv("OPERANDCONFIG_NAME", " ")
+	assert.Equal(t, "common-service", GetOperandConfigName())
+
+	t.Setenv("OPERANDCONFIG_NAME", "common-service-instance-2")
+	assert.Equal(t, "common-service-instance-2", GetOperandConfigName())
+}
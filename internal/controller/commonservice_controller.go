@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/go-logr/logr"
 	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -35,6 +36,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -52,10 +54,25 @@ type CommonServiceReconciler struct {
 	*bootstrap.Bootstrap
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	Log      logr.Logger
+	// Transformers post-process the merged OperandConfig services before
+	// they are written, see RegisterServicesTransformer
+	Transformers []ServicesTransformer
+	// bulkMerge coalesces the merges of the CRs when BulkMergeInterval is set
+	bulkMerge *bulkMergeTrigger
 }
 
 func (r *CommonServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 
+	if isOperandConfigDriftRequest(req) {
+		klog.Infof("Reconciling the drift of OperandConfig: %s", req.NamespacedName)
+		err := r.reconcileOperandConfigDrift(ctx)
+		if result, ok := requeueOnOperandConfigNotFound(err); ok {
+			return result, nil
+		}
+		return ctrl.Result{}, err
+	}
+
 	klog.Infof("Reconciling CommonService: %s", req.NamespacedName)
 
 	// Fetch the CommonService instance
@@ -72,9 +89,13 @@ func (r *CommonServiceReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	if err := r.Reader.Get(ctx, req.NamespacedName, instance); err != nil {
 		if errors.IsNotFound(err) {
-			if err := r.handleDelete(ctx); err != nil {
+			if err := r.handleDelete(ctx, popDeletedCommonService(req.NamespacedName)); err != nil {
+				if result, ok := requeueOnOperandConfigNotFound(err); ok {
+					return result, nil
+				}
 				return ctrl.Result{}, err
 			}
+			r.refreshEffectiveSizing(ctx, nil)
 			// Generate Issuer and Certificate CR
 			if err := r.Bootstrap.DeployCertManagerCR(); err != nil {
 				return ctrl.Result{}, err
@@ -259,6 +280,7 @@ func (r *CommonServiceReconciler) ReconcileMasterCR(ctx context.Context, instanc
 	// Set "Pengding" condition and "Updating" for phase when config CS CR
 	instance.SetPendingCondition(constant.MasterCR, apiv3.ConditionTypeReconciling, corev1.ConditionTrue, apiv3.ConditionReasonConfig, apiv3.ConditionMessageConfig)
 	instance.Status.Phase = apiv3.CRUpdating
+	r.warnUnknownSizeKeys(cs)
 	newConfigs, serviceControllerMapping, statusErr := r.getNewConfigs(cs)
 	if statusErr != nil {
 		klog.Errorf("Fail to reconcile %s/%s: %v", instance.Namespace, instance.Name, err)
@@ -272,14 +294,17 @@ func (r *CommonServiceReconciler) ReconcileMasterCR(ctx context.Context, instanc
 	}
 
 	var isEqual bool
-	if isEqual, statusErr = r.updateOperandConfig(ctx, newConfigs, serviceControllerMapping); statusErr != nil {
+	if isEqual, statusErr = r.updateOperandConfigWithCondition(ctx, instance, newConfigs, serviceControllerMapping); statusErr != nil {
+		if result, ok := requeueOnOperandConfigNotFound(statusErr); ok {
+			return result, nil
+		}
 		if statusErr := r.updatePhase(ctx, instance, apiv3.CRFailed); statusErr != nil {
 			klog.Error(statusErr)
 		}
 		klog.Errorf("Fail to reconcile %s/%s: %v", instance.Namespace, instance.Name, statusErr)
 		return ctrl.Result{}, statusErr
 	} else if isEqual {
-		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, "common-service", instance.Namespace, instance.Name))
+		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, r.Bootstrap.CSData.OperandConfigName, instance.Namespace, instance.Name))
 	}
 
 	if statusErr = r.Bootstrap.UpdateEDBUserManaged(); statusErr != nil {
@@ -358,11 +383,15 @@ func (r *CommonServiceReconciler) ReconcileGeneralCR(ctx context.Context, instan
 	instance.UpdateNonMasterConfigStatus(&r.Bootstrap.CSData)
 
 	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
-	opconKey := types.NamespacedName{
-		Name:      "common-service",
-		Namespace: r.Bootstrap.CSData.ServicesNs,
+	opconKey, err := r.getOperandConfigKey()
+	if err != nil {
+		klog.Errorf("Fail to reconcile %s/%s: %v", instance.Namespace, instance.Name, err)
+		return ctrl.Result{}, err
 	}
 	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
+		if result, ok := requeueOnOperandConfigNotFound(wrapOperandConfigNotFound(opconKey, err)); ok {
+			return result, nil
+		}
 		klog.Errorf("failed to get OperandConfig %s: %v", opconKey.String(), err)
 		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
 			klog.Error(err)
@@ -386,6 +415,7 @@ func (r *CommonServiceReconciler) ReconcileGeneralCR(ctx context.Context, instan
 		return ctrl.Result{}, err
 	}
 
+	r.warnUnknownSizeKeys(cs)
 	newConfigs, serviceControllerMapping, err := r.getNewConfigs(cs)
 	if err != nil {
 		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
@@ -395,8 +425,11 @@ func (r *CommonServiceReconciler) ReconcileGeneralCR(ctx context.Context, instan
 		return ctrl.Result{}, err
 	}
 
-	isEqual, err := r.updateOperandConfig(ctx, newConfigs, serviceControllerMapping)
+	isEqual, err := r.updateOperandConfigWithCondition(ctx, instance, newConfigs, serviceControllerMapping)
 	if err != nil {
+		if result, ok := requeueOnOperandConfigNotFound(err); ok {
+			return result, nil
+		}
 		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
 			klog.Error(err)
 		}
@@ -406,7 +439,7 @@ func (r *CommonServiceReconciler) ReconcileGeneralCR(ctx context.Context, instan
 
 	// Create Event if there is no update in OperandConfig after applying current CR
 	if isEqual {
-		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, "common-service", instance.Namespace, instance.Name))
+		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, r.Bootstrap.CSData.OperandConfigName, instance.Namespace, instance.Name))
 	}
 
 	isEqual, err = r.updateOperatorConfig(ctx, instance.Spec.OperatorConfigs)
@@ -566,6 +599,21 @@ func isNonNoopOperandReconcile(operandRegistry *odlm.OperandRegistry) bool {
 }
 
 func (r *CommonServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	RegisterNonDefaultProfileControllers(r.Bootstrap.CSData.ExtraProfileControllers...)
+	RegisterProfileResetControllers(r.Bootstrap.CSData.ProfileResetControllers...)
+	SetAvgRoundingPolicy(r.Bootstrap.CSData.AvgRoundingPolicy)
+	SetMaxMergeDepth(r.Bootstrap.CSData.MaxMergeDepth)
+	if r.Bootstrap.CSData.BulkMergeInterval > 0 {
+		klog.Infof("Coalescing the merges of the CommonService CRs every %s", r.Bootstrap.CSData.BulkMergeInterval)
+		r.bulkMerge = newBulkMergeTrigger(r.Bootstrap.CSData.BulkMergeInterval)
+		r.bulkMerge.events = make(chan event.GenericEvent)
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			r.bulkMerge.run(ctx, r.reconcileAll)
+			return nil
+		})); err != nil {
+			return err
+		}
+	}
 
 	controller := ctrl.NewControllerManagedBy(mgr).
 		// AnnotationChangedPredicate is intended to be used in conjunction with the GenerationChangedPredicate
@@ -573,7 +621,8 @@ func (r *CommonServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			predicate.Or(
 				predicate.GenerationChangedPredicate{},
 				predicate.AnnotationChangedPredicate{},
-				predicate.LabelChangedPredicate{}))).
+				predicate.LabelChangedPredicate{}),
+			deletedCommonServicePredicate())).
 		Watches(
 			&source.Kind{Type: &corev1.ConfigMap{}},
 			handler.EnqueueRequestsFromMapFunc(r.mappingToCsRequestForConfigMaps()),
@@ -582,6 +631,13 @@ func (r *CommonServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				UpdateFunc: func(e event.UpdateEvent) bool { return true },
 				DeleteFunc: func(e event.DeleteEvent) bool { return !e.DeleteStateUnknown },
 			}))
+	if r.bulkMerge != nil {
+		// The CRs served by a bulk merge pass are reconciled again to set
+		// their ConfigMerged condition from the result of the pass
+		controller = controller.Watches(
+			&source.Channel{Source: r.bulkMerge.events},
+			&handler.EnqueueRequestForObject{})
+	}
 	if isOpregAPI, err := r.Bootstrap.CheckCRD(constant.OpregAPIGroupVersion, constant.OpregKind); err != nil {
 		klog.Errorf("Failed to check if OperandRegistry CRD exists: %v", err)
 		return err
@@ -607,6 +663,24 @@ func (r *CommonServiceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			},
 			))
 	}
+	if isOpconAPI, err := r.Bootstrap.CheckCRD(constant.OpregAPIGroupVersion, constant.OpconKind); err != nil {
+		klog.Errorf("Failed to check if OperandConfig CRD exists: %v", err)
+		return err
+	} else if isOpconAPI {
+		// Restore the sizing computed from the CommonService CRs when the
+		// OperandConfig is edited out of band
+		controller = controller.Watches(
+			&source.Kind{Type: &odlm.OperandConfig{}},
+			handler.EnqueueRequestsFromMapFunc(r.mappingToDriftRequestForOperandConfig()),
+			builder.WithPredicates(operandConfigDriftPredicate()))
+		if r.Bootstrap.CSData.ShadowMergeEnable {
+			// Promote the shadow OperandConfig once it is approved
+			controller = controller.Watches(
+				&source.Kind{Type: &odlm.OperandConfig{}},
+				handler.EnqueueRequestsFromMapFunc(r.mappingToDriftRequestForShadowOperandConfig()),
+				builder.WithPredicates(shadowOperandConfigPredicate()))
+		}
+	}
 	if isSubscriptionAPI, err := r.Bootstrap.CheckCRD(constant.SubscriptionAPIGroupVersion, constant.SubscriptionKind); err != nil {
 		klog.Errorf("Failed to check if Subscription CRD exists: %v", err)
 		return err
@@ -18,16 +18,23 @@ package controllers
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
 
 	"k8s.io/klog"
 
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
 	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
@@ -239,3 +246,91 @@ spec:
     operands:
       - name: ibm-mongodb-operator
 `
+
+// newTestDiscoveryServer serves the discovery of an apiserver without any API
+// group, e.g. without the cert-manager CRDs
+func newTestDiscoveryServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/api":
+			_, _ = w.Write([]byte(`{"kind":"APIVersions","versions":[]}`))
+		case "/apis":
+			_, _ = w.Write([]byte(`{"kind":"APIGroupList","groups":[]}`))
+		default:
+			http.NotFound(w, req)
+		}
+	}))
+}
+
+var _ = Describe("Reconcile with a custom OperandConfig name", func() {
+	var (
+		r         *CommonServiceReconciler
+		discovery *httptest.Server
+		// The values of the environment variables before the spec, nil
+		// when unset
+		envs map[string]*string
+	)
+
+	setenv := func(key, value string) {
+		if _, saved := envs[key]; !saved {
+			if old, ok := os.LookupEnv(key); ok {
+				envs[key] = &old
+			} else {
+				envs[key] = nil
+			}
+		}
+		Expect(os.Setenv(key, value)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		envs = map[string]*string{}
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`))
+		opcon.SetName("common-service-instance-2")
+		cs := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		// The OperatorConfigs of the CR are applied through the OperandRegistry
+		opreg := &odlm.OperandRegistry{ObjectMeta: metav1.ObjectMeta{Name: "common-service", Namespace: testServicesNs}}
+		setenv(constant.OperatorNamespaceEnvVar, testServicesNs)
+
+		// No OperandConfig named common-service exists
+		r = newTestReconciler(opcon, cs, opreg)
+		discovery = newTestDiscoveryServer()
+		r.Bootstrap.Config = &rest.Config{Host: discovery.URL}
+		r.Bootstrap.CSData.OperandConfigName = "common-service-instance-2"
+	})
+
+	AfterEach(func() {
+		discovery.Close()
+		for key, value := range envs {
+			if value == nil {
+				Expect(os.Unsetenv(key)).To(Succeed())
+			} else {
+				Expect(os.Setenv(key, *value)).To(Succeed())
+			}
+		}
+	})
+
+	DescribeTable("should merge the CR into the OperandConfig of the configured name",
+		func(noOLM string) {
+			setenv("NO_OLM", noOLM)
+			result, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "tenant-a", Name: "example-service"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			spec := getTestServiceSpec(getTestOperandConfig(r, "common-service-instance-2"), "ibm-im-mongodb-operator", "mongoDB")
+			Expect(spec["replicas"]).To(BeEquivalentTo(3))
+		},
+		Entry("with OLM", "false"),
+		Entry("without OLM", "true"),
+	)
+})
@@ -0,0 +1,76 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// nonQuantityKeys are the keys under the limits or requests which hold configs
+// rather than quantities, even when their values parse as quantities, e.g. a
+// version "1.2"
+var nonQuantityKeys = map[string]bool{
+	"class":   true,
+	"image":   true,
+	"name":    true,
+	"tag":     true,
+	"type":    true,
+	"version": true,
+}
+
+// isComparableLeaf checks if the value is a number or a string, which are
+// compared as numbers or resource quantities
+func isComparableLeaf(value interface{}) bool {
+	switch value.(type) {
+	case string, float64, int64, int:
+		return true
+	}
+	return false
+}
+
+// isComparablePair checks if the two values can be compared by
+// rules.ResourceComparison, either both are quantities or numbers, or both
+// are bools like fipsEnabled
+func isComparablePair(a, b interface{}) bool {
+	_, isBoolA := a.(bool)
+	_, isBoolB := b.(bool)
+	if isBoolA || isBoolB {
+		return isBoolA && isBoolB
+	}
+	return isComparableLeaf(a) && isComparableLeaf(b)
+}
+
+// isQuantityLeaf checks if the key under the limits or requests of a resources
+// block holds a quantity on both sides, e.g. hugepages-2Mi or nvidia.com/gpu,
+// so it is compared like the cpu and the memory
+func isQuantityLeaf(parentKey, key string, a, b interface{}) bool {
+	if !resourcesKeys[parentKey] || nonQuantityKeys[key] {
+		return false
+	}
+	return isQuantityValue(a) && isQuantityValue(b)
+}
+
+func isQuantityValue(value interface{}) bool {
+	switch value := value.(type) {
+	case string:
+		_, err := resource.ParseQuantity(value)
+		return err == nil
+	case float64, int64, int:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("merging incomparable values", func() {
+	var defaultMap, changedMap map[string]interface{}
+
+	BeforeEach(func() {
+		defaultMap = map[string]interface{}{
+			"resources": map[string]interface{}{
+				"limits": map[string]interface{}{
+					"cpu":    "1",
+					"memory": "1Gi",
+				},
+			},
+			"fipsEnabled": false,
+		}
+		changedMap = map[string]interface{}{
+			"resources": map[string]interface{}{
+				"limits": map[string]interface{}{
+					"cpu":    true,
+					"memory": "2Gi",
+				},
+			},
+			"fipsEnabled": true,
+		}
+	})
+
+	It("should fall back to the default for the bool cpu and compare the other values", func() {
+		merged := mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), defaultMap, changedMap, false)
+		Expect(merged).To(Equal(map[string]interface{}{
+			"resources": map[string]interface{}{
+				"limits": map[string]interface{}{
+					"cpu":    "1",
+					"memory": "2Gi",
+				},
+			},
+			// The bools are still compared, false is the larger one
+			"fipsEnabled": false,
+		}))
+	})
+
+	DescribeTable("should keep the default in the extreme sizes",
+		func(extreme Extreme) {
+			shrunk := shrinkSize(logr.Discard(), defaultMap, changedMap, nil, extreme)
+			cpu, _, _ := unstructured.NestedFieldNoCopy(shrunk, "resources", "limits", "cpu")
+			Expect(cpu).To(Equal("1"))
+		},
+		Entry("merged by the largest value", Max),
+		Entry("merged by the smallest value", Min),
+		Entry("merged by the sum", Sum),
+	)
+
+	It("should only compare the values of the same kind", func() {
+		Expect(isComparablePair("1", int64(2))).To(BeTrue())
+		Expect(isComparablePair(true, false)).To(BeTrue())
+		Expect(isComparablePair("1", true)).To(BeFalse())
+		Expect(isComparablePair(map[string]interface{}{}, "1")).To(BeFalse())
+	})
+})
+
+var _ = Describe("mergeConfigs comparing the quantities by name", func() {
+	var (
+		ruleSlice     []interface{}
+		opconServices = `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          hugepages-2Mi: 64Mi
+          nvidia.com/gpu: "1"
+`
+		small = `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          hugepages-2Mi: 128Mi
+          nvidia.com/gpu: "2"
+`
+		large = `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          hugepages-2Mi: 256Mi
+          nvidia.com/gpu: "1"
+`
+	)
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          hugepages-2Mi: LARGEST_VALUE
+          nvidia.com/gpu: LARGEST_VALUE
+`)
+	})
+
+	DescribeTable("should pick the larger quantities under Max, whatever the order of the CRs",
+		func(first, second string) {
+			csConfigsList := [][]interface{}{mustConvertStringToSlice(first), mustConvertStringToSlice(second)}
+			services := mustMergeConfigs(mustConvertStringToSlice(opconServices), csConfigsList, ruleSlice, map[string]string{"profileController": "default"}, Max, testServicesNs)
+			limits, _, _ := unstructured.NestedStringMap(services[0].(map[string]interface{}), "spec", "testCR", "resources", "limits")
+			Expect(limits).To(Equal(map[string]string{"hugepages-2Mi": "256Mi", "nvidia.com/gpu": "2"}))
+		},
+		Entry("with the small CR first", small, large),
+		Entry("with the large CR first", large, small),
+	)
+
+	DescribeTable("should only compare the quantities under the limits or requests",
+		func(parent, key, a, b string, expected bool) {
+			Expect(isQuantityLeaf(parent, key, a, b)).To(Equal(expected))
+		},
+		Entry("a hugepages request", "requests", "hugepages-1Gi", "1Gi", "2Gi", true),
+		Entry("a version under the limits", "limits", "version", "1.2", "1.3", false),
+		Entry("a mode under the limits", "limits", "mode", "fast", "1", false),
+		Entry("a hugepages key outside the resources", "testCR", "hugepages-2Mi", "128Mi", "256Mi", false),
+	)
+})
@@ -0,0 +1,149 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mohae/deepcopy"
+
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+var (
+	configurationRulesOnce  sync.Once
+	configurationRulesSlice []interface{}
+	configurationRulesErr   error
+)
+
+// getConfigurationRules returns the ConfigurationRules parsed into a slice.
+// The rules are parsed once, and every caller gets its own copy, so it can be
+// mutated safely.
+func getConfigurationRules() ([]interface{}, error) {
+	configurationRulesOnce.Do(func() {
+		configurationRulesSlice, configurationRulesErr = convertStringToSlice(rules.ConfigurationRules)
+	})
+	if configurationRulesErr != nil {
+		return nil, configurationRulesErr
+	}
+	return deepcopy.Copy(configurationRulesSlice).([]interface{}), nil
+}
+
+// ValidateConfigurationRules checks the configuration rules are well formed:
+// every rule names its operator, the spec maps each CR to a tree whose leaves
+// are rules, and each resource names its apiVersion, kind and name, and holds
+// a rule tree in its data. It returns an error listing all the problems found.
+func ValidateConfigurationRules(rulesYAML string) error {
+	ruleSlice, err := convertStringToSlice(rulesYAML)
+	if err != nil {
+		return fmt.Errorf("failed to parse the configuration rules: %w", err)
+	}
+
+	var problems []string
+	names := map[string]bool{}
+	for i, rule := range ruleSlice {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("rule %d: is not an object", i))
+			continue
+		}
+		name, _ := ruleMap["name"].(string)
+		if name == "" {
+			problems = append(problems, fmt.Sprintf("rule %d: name is not set", i))
+			name = fmt.Sprintf("rule %d", i)
+		} else if names[name] {
+			problems = append(problems, fmt.Sprintf("%s: is defined more than once", name))
+		}
+		names[name] = true
+
+		if spec, ok := ruleMap["spec"]; ok {
+			specMap, ok := spec.(map[string]interface{})
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: spec is not an object", name))
+			} else {
+				for cr, crRules := range specMap {
+					if _, ok := crRules.(map[string]interface{}); !ok {
+						problems = append(problems, fmt.Sprintf("%s: spec.%s is not an object", name, cr))
+						continue
+					}
+					problems = findInvalidRules(name+": spec."+cr, crRules, problems)
+				}
+			}
+		}
+
+		if resources, ok := ruleMap["resources"]; ok {
+			resourceSlice, ok := resources.([]interface{})
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s: resources is not a list", name))
+				continue
+			}
+			for j, resource := range resourceSlice {
+				problems = findInvalidResourceRule(fmt.Sprintf("%s: resources[%d]", name, j), resource, problems)
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("invalid configuration rules: %s", strings.Join(problems, "; "))
+}
+
+func findInvalidResourceRule(path string, resource interface{}, problems []string) []string {
+	resourceMap, ok := resource.(map[string]interface{})
+	if !ok {
+		return append(problems, path+" is not an object")
+	}
+	for _, key := range []string{"apiVersion", "kind", "name"} {
+		if value, _ := resourceMap[key].(string); value == "" {
+			problems = append(problems, path+"."+key+" is not set")
+		}
+	}
+	if _, ok := resourceMap["data"].(map[string]interface{}); !ok {
+		return append(problems, path+".data is not an object")
+	}
+	return findInvalidRules(path+".data", resourceMap["data"], problems)
+}
+
+// findInvalidRules walks a rule tree, whose leaves must be the rule names,
+// e.g. LARGEST_VALUE
+func findInvalidRules(path string, value interface{}, problems []string) []string {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if len(value) == 0 {
+			return append(problems, path+" has no rules")
+		}
+		for key, child := range value {
+			problems = findInvalidRules(path+"."+key, child, problems)
+		}
+	case []interface{}:
+		for i, child := range value {
+			problems = findInvalidRules(fmt.Sprintf("%s[%d]", path, i), child, problems)
+		}
+	case string:
+		if value == "" {
+			return append(problems, path+" has an empty rule")
+		}
+	default:
+		return append(problems, fmt.Sprintf("%s has the rule %v, which is not a string", path, value))
+	}
+	return problems
+}
@@ -0,0 +1,98 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/mohae/deepcopy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+var _ = Describe("getConfigurationRules", func() {
+	It("should return a copy of the rules to every caller", func() {
+		ruleSlice, err := getConfigurationRules()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ruleSlice).NotTo(BeEmpty())
+		expected := deepcopy.Copy(ruleSlice)
+
+		// Mutating the returned rules doesn't bleed into the next caller
+		ruleSlice[0].(map[string]interface{})["name"] = "mutated-operator"
+		ruleSlice[1] = nil
+
+		again, err := getConfigurationRules()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(expected))
+	})
+})
+
+var _ = Describe("ValidateConfigurationRules", func() {
+	brokenRules := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+- name: ibm-broken-operator
+  spec:
+    broken:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu:
+  resources:
+  - apiVersion: apps/v1
+    name: broken-deployment
+    data:
+      spec:
+        replicas: LARGEST_VALUE
+- spec:
+    unnamed:
+      replicas: LARGEST_VALUE
+`
+
+	It("should accept the shipped rules", func() {
+		Expect(ValidateConfigurationRules(rules.ConfigurationRules)).To(Succeed())
+	})
+
+	It("should report every broken rule", func() {
+		err := ValidateConfigurationRules(brokenRules)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("ibm-broken-operator: resources[0].kind is not set"))
+		Expect(err.Error()).To(ContainSubstring("ibm-broken-operator: spec.broken.resources.limits.cpu has the rule <nil>, which is not a string"))
+		Expect(err.Error()).To(ContainSubstring("rule 2: name is not set"))
+		Expect(err.Error()).NotTo(ContainSubstring("ibm-im-mongodb-operator"))
+	})
+})
+
+func BenchmarkConvertConfigurationRules(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := convertStringToSlice(rules.ConfigurationRules); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetConfigurationRules(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := getConfigurationRules(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
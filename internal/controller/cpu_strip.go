@@ -0,0 +1,106 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// CPUStripEventReason is the reason of the event recorded when the cpu limit
+// is stripped
+const CPUStripEventReason = "CPULimitStripped"
+
+// stripCPULimit deletes the cpu limit of the merged resource when the
+// operator is managed by a non-default profile controller resetting the cpu.
+// The external controller right-sizes the cpu of the operand at runtime, and
+// a static limit from the OperandConfig would cap it and throttle the operand,
+// so the limit is left out for the controller to own.
+func stripCPULimit(logger logr.Logger, resource interface{}, operator, controller string) {
+	if !isNonDefaultProfileController(controller) || !getProfileControllerResetKeys(controller)["cpu"] || !isOpResourceExists(resource) {
+		return
+	}
+	resources, _ := getOpResourceResources(resource)
+	limits, ok := resources["limits"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	if _, ok := limits["cpu"]; !ok {
+		return
+	}
+	logger.V(2).Info("Stripping the cpu limit", "operator", operator, "profileController", controller, "kind", resource.(map[string]interface{})["kind"], "name", resource.(map[string]interface{})["name"])
+	delete(limits, "cpu")
+	cpuStripTotal.WithLabelValues(operator, controller).Inc()
+}
+
+// recordCPUStripEvents records an event on the OperandConfig for each
+// resource whose cpu limit is stripped by the merge, the cpu limit is set in
+// the existing services but is gone from the merged services
+func (r *CommonServiceReconciler) recordCPUStripEvents(opcon *unstructured.Unstructured, existingServices, opconServices []interface{}) {
+	for _, opService := range opconServices {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			klog.Warningf("Skipping the cpu strip events of operator %v, because it is not an object", opService)
+			continue
+		}
+		operatorName, ok := opServiceMap["name"].(string)
+		if !ok || operatorName == "" {
+			klog.Warningf("Skipping the cpu strip events of operator %v, because its name is not a string", opServiceMap["name"])
+			continue
+		}
+		opResources, ok := opServiceMap["resources"].([]interface{})
+		if !ok {
+			continue
+		}
+		existingService, ok := getItemByName(existingServices, operatorName).(map[string]interface{})
+		if !ok {
+			continue
+		}
+		existingResources, ok := existingService["resources"].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, opResource := range opResources {
+			opResourceMap, ok := opResource.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, found, _ := unstructured.NestedFieldNoCopy(opResourceMap, "data", "spec", "resources", "limits", "cpu"); found {
+				continue
+			}
+			apiVersion, _ := opResourceMap["apiVersion"].(string)
+			kind, _ := opResourceMap["kind"].(string)
+			name, _ := opResourceMap["name"].(string)
+			namespace, _ := opResourceMap["namespace"].(string)
+			if namespace == "" {
+				namespace = opcon.GetNamespace()
+			}
+			existingResource, ok := getItemByGVKNameNamespace(existingResources, opcon.GetNamespace(), apiVersion, kind, name, namespace).(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if _, found, _ := unstructured.NestedFieldNoCopy(existingResource, "data", "spec", "resources", "limits", "cpu"); !found {
+				continue
+			}
+			r.Recorder.Event(opcon, corev1.EventTypeNormal, CPUStripEventReason, fmt.Sprintf("The cpu limit of %s %s for operator %s is stripped, it is handed off to the non-default profile controller", kind, name, operatorName))
+		}
+	}
+}
@@ -0,0 +1,139 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("CPU strip events", func() {
+	opconServices := `
+- name: ibm-test-operator
+  spec:
+    testCR: {}
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            cpu: 100m
+            memory: 256Mi
+`
+	newConfigs := `
+- name: ibm-test-operator
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            cpu: 200m
+            memory: 512Mi
+`
+	stripCount := func() float64 {
+		return testutil.ToFloat64(cpuStripTotal.WithLabelValues("ibm-test-operator", "turbo"))
+	}
+
+	var (
+		r      *CommonServiceReconciler
+		before float64
+	)
+
+	BeforeEach(func() {
+		r = newTestReconciler()
+		before = stripCount()
+	})
+
+	recordStrips := func(mapping map[string]string) {
+		services := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices), mustConvertStringToSlice(newConfigs), nil, mapping, testServicesNs, 1)
+		r.recordCPUStripEvents(newTestOperandConfig(mustConvertStringToSlice(opconServices)), mustConvertStringToSlice(opconServices), services)
+	}
+
+	It("should not record the strip for the default profile controller", func() {
+		recordStrips(map[string]string{"profileController": "default"})
+		Expect(stripCount()).To(Equal(before))
+		Expect(r.Recorder.(*record.FakeRecorder).Events).To(BeEmpty())
+	})
+
+	It("should record the strip for the operator managed by turbo", func() {
+		recordStrips(map[string]string{"profileController": "default", "ibm-test-operator": "turbo"})
+		Expect(stripCount()).To(Equal(before + 1))
+		Expect(r.Recorder.(*record.FakeRecorder).Events).To(HaveLen(1))
+		Expect(<-r.Recorder.(*record.FakeRecorder).Events).To(ContainSubstring(CPUStripEventReason))
+	})
+})
+
+var _ = Describe("updateOperandConfig for turbo", func() {
+	It("should delete the cpu limit of the operator managed by turbo", func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR: {}
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            cpu: 100m
+            memory: 256Mi
+`))
+		newConfigs := `
+- name: ibm-test-operator
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            cpu: 200m
+            memory: 512Mi
+`
+		r := newTestReconciler(opcon)
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), map[string]string{"profileController": "default", "ibm-test-operator": "turbo"})
+		Expect(err).NotTo(HaveOccurred())
+
+		// The cpu limit is deleted, while the other limits are merged
+		services, _, _ := unstructured.NestedSlice(getTestOperandConfig(r, "common-service").Object, "spec", "services")
+		resource := getItemByName(services, "ibm-test-operator").(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})
+		limits, _, _ := unstructured.NestedMap(resource, "data", "spec", "resources", "limits")
+		Expect(limits).NotTo(HaveKey("cpu"))
+		Expect(limits["memory"]).To(Equal("512Mi"))
+
+		// The merged services serialize without the empty struct
+		serialized, err := json.Marshal(services)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(serialized)).NotTo(ContainSubstring(`"cpu":{}`))
+	})
+})
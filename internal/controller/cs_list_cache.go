@@ -0,0 +1,127 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+// csListCacheSize is the number of the CommonService list conversions kept
+const csListCacheSize = 8
+
+// csListCache caches the unstructured conversions of the CommonService lists
+var csListCache = newUnstructuredListCache(csListCacheSize)
+
+// unstructuredListCache is a bounded LRU cache of the unstructured conversions
+// of the object lists, keyed by the resourceVersions of the lists
+type unstructuredListCache struct {
+	lock    sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	// order holds the entries, the most recently used first
+	order *list.List
+}
+
+type unstructuredListCacheEntry struct {
+	key   string
+	items []unstructured.Unstructured
+}
+
+func newUnstructuredListCache(size int) *unstructuredListCache {
+	return &unstructuredListCache{
+		size:    size,
+		entries: map[string]*list.Element{},
+		order:   list.New(),
+	}
+}
+
+// get returns a copy of the cached items, the merge mutates them
+func (c *unstructuredListCache) get(key string) ([]unstructured.Unstructured, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return copyUnstructuredItems(element.Value.(*unstructuredListCacheEntry).items), true
+}
+
+// add caches a copy of the items, evicting the least recently used entry when
+// the cache is full
+func (c *unstructuredListCache) add(key string, items []unstructured.Unstructured) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&unstructuredListCacheEntry{key: key, items: copyUnstructuredItems(items)})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*unstructuredListCacheEntry).key)
+	}
+}
+
+func (c *unstructuredListCache) len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.order.Len()
+}
+
+func copyUnstructuredItems(items []unstructured.Unstructured) []unstructured.Unstructured {
+	copied := make([]unstructured.Unstructured, len(items))
+	for i := range items {
+		items[i].DeepCopyInto(&copied[i])
+	}
+	return copied
+}
+
+// commonServiceListKey returns the collective resourceVersion of the list, it
+// changes when any CR is added, removed or changed
+func commonServiceListKey(csObjectList *apiv3.CommonServiceList) string {
+	var key strings.Builder
+	key.WriteString(csObjectList.ResourceVersion)
+	for _, cs := range csObjectList.Items {
+		key.WriteString(";" + cs.Namespace + "/" + cs.Name + "/" + string(cs.UID) + "/" + cs.ResourceVersion)
+	}
+	return key.String()
+}
+
+// commonServiceListToUnstructured converts the CommonService list into the
+// unstructured items, reusing the previous conversion of an unchanged list.
+// The items are copies, safe to mutate.
+func commonServiceListToUnstructured(csObjectList *apiv3.CommonServiceList) ([]unstructured.Unstructured, error) {
+	key := commonServiceListKey(csObjectList)
+	if items, ok := csListCache.get(key); ok {
+		return items, nil
+	}
+	csList, err := util.ObjectListToNewUnstructuredList(csObjectList)
+	if err != nil {
+		return nil, err
+	}
+	csListCache.add(key, csList.Items)
+	return csList.Items, nil
+}
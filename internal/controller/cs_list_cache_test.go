@@ -0,0 +1,104 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("listActiveCommonServices cache", func() {
+	var r *CommonServiceReconciler
+
+	getReplicas := func() interface{} {
+		activeCRs, err := r.listActiveCommonServices(context.TODO(), logr.Discard())
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, activeCRs).To(HaveLen(1))
+		services, _, _ := unstructured.NestedSlice(activeCRs[0].Object, "spec", "services")
+		replicas, _, _ := unstructured.NestedFieldCopy(services[0].(map[string]interface{}), "spec", "mongoDB", "replicas")
+		// Mutate the returned CR like the merge does
+		activeCRs[0].Object["spec"] = nil
+		return replicas
+	}
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`))
+	})
+
+	It("should serve a copy of the cached conversion", func() {
+		Expect(getReplicas()).To(BeEquivalentTo(3))
+		Expect(getReplicas()).To(BeEquivalentTo(3))
+		Expect(csListCache.len()).To(Equal(1))
+	})
+
+	It("should not serve a changed CR from the stale conversion", func() {
+		Expect(getReplicas()).To(BeEquivalentTo(3))
+		current := &apiv3.CommonService{}
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Namespace: "tenant-a", Name: "example-service"}, current)).To(Succeed())
+		current.Spec.Services[0].Spec["mongoDB"] = apiv3.ExtensionWithMarker{RawExtension: runtime.RawExtension{Raw: []byte(`{"replicas":5}`)}}
+		Expect(r.Client.Update(context.TODO(), current)).To(Succeed())
+		Expect(getReplicas()).To(BeEquivalentTo(5))
+		Expect(csListCache.len()).To(Equal(2))
+	})
+
+	It("should evict the least recently used conversion", func() {
+		cache := newUnstructuredListCache(2)
+		for _, key := range []string{"1", "2", "1", "3"} {
+			cache.add(key, nil)
+		}
+		_, ok := cache.get("2")
+		Expect(ok).To(BeFalse())
+		_, ok = cache.get("1")
+		Expect(ok).To(BeTrue())
+		Expect(cache.len()).To(Equal(2))
+	})
+})
+
+func BenchmarkListActiveCommonServices(b *testing.B) {
+	var objs []client.Object
+	for i := 0; i < 50; i++ {
+		cs := &apiv3.CommonService{}
+		cs.SetNamespace(fmt.Sprintf("tenant-%d", i))
+		cs.SetName("common-service")
+		cs.Spec.Size = "medium"
+		objs = append(objs, cs)
+	}
+	r := newTestReconciler(objs...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := r.listActiveCommonServices(context.TODO(), logr.Discard()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
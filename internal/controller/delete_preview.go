@@ -0,0 +1,84 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/mohae/deepcopy"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+// SizingChange is the OperandConfig service of an operator, with its spec and
+// resources, before and after a change
+type SizingChange struct {
+	Before map[string]interface{}
+	After  map[string]interface{}
+}
+
+// PreviewDelete returns the sizing of the operators which would change if the
+// CommonService CR were deleted, keyed by the operator name. The shrinking is
+// computed like handleDelete, as if the CR were gone, without updating the
+// OperandConfig.
+func (r *CommonServiceReconciler) PreviewDelete(ctx context.Context, instance *apiv3.CommonService) (map[string]SizingChange, error) {
+	opconKey, err := r.getOperandConfigKey()
+	if err != nil {
+		return nil, err
+	}
+	logger := r.mergeLogger().WithValues("operandConfig", opconKey.String(), "preview", true)
+	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
+		return nil, wrapOperandConfigNotFound(opconKey, err)
+	}
+	opconServices, err := getOperandConfigServices(opcon)
+	if err != nil {
+		return nil, err
+	}
+	existingOpconServices := deepcopy.Copy(opconServices).([]interface{})
+
+	excluded := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+	shrunkServices, err := r.shrinkOperandConfigServices(ctx, logger, opconKey, opconServices, instance, &excluded)
+	if err != nil {
+		return nil, err
+	}
+	changes := map[string]SizingChange{}
+	if shrunkServices == nil {
+		return changes, nil
+	}
+	for _, operator := range getChangedOperators(existingOpconServices, shrunkServices) {
+		before, _ := getItemByName(existingOpconServices, operator).(map[string]interface{})
+		after, _ := getItemByName(shrunkServices, operator).(map[string]interface{})
+		changes[operator] = SizingChange{Before: before, After: after}
+	}
+	return changes, nil
+}
+
+// excludeCommonService returns the CommonService CRs without the excluded one
+func excludeCommonService(csList []unstructured.Unstructured, excluded types.NamespacedName) []unstructured.Unstructured {
+	var kept []unstructured.Unstructured
+	for _, cs := range csList {
+		if cs.GetNamespace() == excluded.Namespace && cs.GetName() == excluded.Name {
+			continue
+		}
+		kept = append(kept, cs)
+	}
+	return kept
+}
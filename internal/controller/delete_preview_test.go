@@ -0,0 +1,108 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("PreviewDelete", func() {
+	var (
+		r             *CommonServiceReconciler
+		deleted       *apiv3.CommonService
+		tenant        *apiv3.CommonService
+		opconServices = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+- name: ibm-licensing-operator
+  spec:
+    IBMLicensing:
+      resources:
+        limits:
+          cpu: 500m
+`
+	)
+
+	getSpecJSON := func() string {
+		spec, err := json.Marshal(getTestOperandConfig(r, "common-service").Object["spec"])
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return string(spec)
+	}
+
+	BeforeEach(func() {
+		deleted = newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		tenant = newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+  - name: ibm-licensing-operator
+    spec:
+      IBMLicensing:
+        resources:
+          limits:
+            cpu: 500m
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(opconServices)), deleted.DeepCopy(), tenant.DeepCopy())
+	})
+
+	It("should preview the sizing produced by the deletion", func() {
+		existing := getSpecJSON()
+
+		By("shrinking the operators of the deleted CR, leaving the OperandConfig untouched")
+		changes, err := r.PreviewDelete(context.TODO(), deleted.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes).To(HaveKey("ibm-im-mongodb-operator"))
+		change := changes["ibm-im-mongodb-operator"]
+		Expect(change.Before["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(3))
+		Expect(change.After["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(1))
+		Expect(getSpecJSON()).To(Equal(existing))
+
+		By("deleting the CR")
+		Expect(r.Client.Delete(context.TODO(), deleted.DeepCopy())).To(Succeed())
+		Expect(r.handleDelete(context.TODO(), deleted.DeepCopy())).To(Succeed())
+		services, _, _ := unstructured.NestedSlice(getTestOperandConfig(r, "common-service").Object, "spec", "services")
+		previewed, err := json.Marshal(change.After)
+		Expect(err).NotTo(HaveOccurred())
+		deletedService, err := json.Marshal(getItemByName(services, "ibm-im-mongodb-operator"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(deletedService)).To(Equal(string(previewed)))
+	})
+
+	It("should return the sentinel without the OperandConfig", func() {
+		r = newTestReconciler(tenant.DeepCopy())
+		_, err := r.PreviewDelete(context.TODO(), tenant.DeepCopy())
+		Expect(err).To(MatchError(ErrOperandConfigNotFound))
+	})
+})
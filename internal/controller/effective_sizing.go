@@ -0,0 +1,162 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+)
+
+// effectiveSizingFromServices summarizes the replicas, cpu and memory of the
+// CRs in the OperandConfig services, sorted by the operator and the CR names.
+// The CRs without any of them are left out.
+func effectiveSizingFromServices(services []interface{}) []apiv3.EffectiveSizing {
+	var sizing []apiv3.EffectiveSizing
+	for _, service := range services {
+		serviceMap, ok := service.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := serviceMap["name"].(string)
+		spec, ok := serviceMap["spec"].(map[string]interface{})
+		if name == "" || !ok {
+			continue
+		}
+		var crs []apiv3.EffectiveCRSizing
+		for cr, crSpec := range spec {
+			crMap, ok := crSpec.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			crSizing := apiv3.EffectiveCRSizing{Name: cr, Replicas: effectiveReplicas(crMap["replicas"])}
+			crSizing.Limits = effectiveQuantities(crMap, "limits")
+			crSizing.Requests = effectiveQuantities(crMap, "requests")
+			if crSizing.Replicas == nil && crSizing.Limits == (apiv3.EffectiveQuantities{}) && crSizing.Requests == (apiv3.EffectiveQuantities{}) {
+				continue
+			}
+			crs = append(crs, crSizing)
+		}
+		if len(crs) == 0 {
+			continue
+		}
+		sort.Slice(crs, func(i, j int) bool {
+			return crs[i].Name < crs[j].Name
+		})
+		sizing = append(sizing, apiv3.EffectiveSizing{Name: name, CRs: crs})
+	}
+	sort.Slice(sizing, func(i, j int) bool {
+		return sizing[i].Name < sizing[j].Name
+	})
+	return sizing
+}
+
+func effectiveReplicas(value interface{}) *int64 {
+	var replicas int64
+	switch value := value.(type) {
+	case int64:
+		replicas = value
+	case int:
+		replicas = int64(value)
+	case float64:
+		if value != math.Trunc(value) {
+			return nil
+		}
+		replicas = int64(value)
+	default:
+		return nil
+	}
+	return &replicas
+}
+
+func effectiveQuantities(crMap map[string]interface{}, key string) apiv3.EffectiveQuantities {
+	quantities, _, _ := unstructured.NestedFieldNoCopy(crMap, "resources", key)
+	quantitiesMap, ok := quantities.(map[string]interface{})
+	if !ok {
+		return apiv3.EffectiveQuantities{}
+	}
+	return apiv3.EffectiveQuantities{
+		CPU:    effectiveQuantity(quantitiesMap["cpu"]),
+		Memory: effectiveQuantity(quantitiesMap["memory"]),
+	}
+}
+
+func effectiveQuantity(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case int64, int, float64:
+		return fmt.Sprintf("%v", value)
+	}
+	return ""
+}
+
+// refreshEffectiveSizing records the sizing in the OperandConfig on the status
+// of the master CR. The status of the master instance being reconciled is set
+// in place and persisted with its phase, the master CR is patched otherwise.
+// It is best effort, the sizing is recorded again on the next merge.
+func (r *CommonServiceReconciler) refreshEffectiveSizing(ctx context.Context, instance *apiv3.CommonService) {
+	opconKey, err := r.getOperandConfigKey()
+	if err != nil {
+		return
+	}
+	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
+		if !errors.IsNotFound(err) {
+			klog.Errorf("Failed to get the OperandConfig %s to summarize the effective sizing: %v", opconKey.String(), err)
+		}
+		return
+	}
+	services, err := getOperandConfigServices(opcon)
+	if err != nil {
+		return
+	}
+	sizing := effectiveSizingFromServices(services)
+
+	if instance != nil && r.checkNamespace(instance.Namespace+"/"+instance.Name) {
+		instance.Status.EffectiveSizing = sizing
+		return
+	}
+	master := &apiv3.CommonService{}
+	masterKey := types.NamespacedName{Namespace: r.Bootstrap.CSData.OperatorNs, Name: constant.MasterCR}
+	if err := r.Client.Get(ctx, masterKey, master); err != nil {
+		if !errors.IsNotFound(err) {
+			klog.Errorf("Failed to get the master CommonService %s to record the effective sizing: %v", masterKey.String(), err)
+		}
+		return
+	}
+	if reflect.DeepEqual(master.Status.EffectiveSizing, sizing) {
+		return
+	}
+	original := master.DeepCopy()
+	master.Status.EffectiveSizing = sizing
+	if err := r.Client.Status().Patch(ctx, master, client.MergeFrom(original)); err != nil {
+		klog.Errorf("Failed to record the effective sizing on the master CommonService %s: %v", masterKey.String(), err)
+	}
+}
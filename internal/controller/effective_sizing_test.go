@@ -0,0 +1,159 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("effectiveSizingFromServices", func() {
+	It("should summarize the sizing of the services", func() {
+		services := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+    noSizing:
+      enabled: true
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: "2"
+          memory: 4Gi
+        requests:
+          cpu: 500m
+- name: ibm-empty-operator
+  spec:
+    emptyCR: {}
+`)
+		three, two := int64(3), int64(2)
+		Expect(effectiveSizingFromServices(services)).To(Equal([]apiv3.EffectiveSizing{
+			{Name: "ibm-im-mongodb-operator", CRs: []apiv3.EffectiveCRSizing{{
+				Name:     "mongoDB",
+				Replicas: &three,
+				Limits:   apiv3.EffectiveQuantities{CPU: "2", Memory: "4Gi"},
+				Requests: apiv3.EffectiveQuantities{CPU: "500m"},
+			}}},
+			{Name: "ibm-test-operator", CRs: []apiv3.EffectiveCRSizing{{Name: "testCR", Replicas: &two}}},
+		}))
+	})
+
+	It("should return nil without services", func() {
+		Expect(effectiveSizingFromServices(nil)).To(BeNil())
+	})
+})
+
+var _ = Describe("EffectiveSizing on the master CommonService", func() {
+	var (
+		r         *CommonServiceReconciler
+		mapping   = map[string]string{"profileController": "default"}
+		masterKey = types.NamespacedName{Namespace: testServicesNs, Name: "common-service"}
+	)
+
+	getMasterSizing := func() []apiv3.EffectiveSizing {
+		cs := &apiv3.CommonService{}
+		ExpectWithOffset(1, r.Client.Get(context.TODO(), masterKey, cs)).To(Succeed())
+		return cs.Status.EffectiveSizing
+	}
+
+	BeforeEach(func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          memory: 1Gi
+`))
+		master := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+`)
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		r = newTestReconciler(opcon, master, tenant)
+	})
+
+	It("should track the sizing merged from all the CRs", func() {
+		By("setting the status of the master instance being reconciled in place")
+		instance := &apiv3.CommonService{}
+		Expect(r.Client.Get(context.TODO(), masterKey, instance)).To(Succeed())
+		_, err := r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		three := int64(3)
+		Expect(instance.Status.EffectiveSizing).To(Equal([]apiv3.EffectiveSizing{{Name: "ibm-im-mongodb-operator", CRs: []apiv3.EffectiveCRSizing{{
+			Name:     "mongoDB",
+			Replicas: &three,
+			Limits:   apiv3.EffectiveQuantities{Memory: "1Gi"},
+		}}}}))
+		Expect(r.Client.Status().Update(context.TODO(), instance)).To(Succeed())
+
+		By("updating the status of the master CR on the merge of a tenant CR")
+		instance = &apiv3.CommonService{}
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Namespace: "tenant-a", Name: "example-service"}, instance)).To(Succeed())
+		instance.Spec.Services[0].Spec["mongoDB"] = apiv3.ExtensionWithMarker{RawExtension: runtime.RawExtension{Raw: []byte(`{"replicas":5}`)}}
+		Expect(r.Client.Update(context.TODO(), instance)).To(Succeed())
+		_, err = r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 5
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(instance.Status.EffectiveSizing).To(BeEmpty())
+		sizing := getMasterSizing()
+		Expect(sizing).To(HaveLen(1))
+		Expect(sizing[0].CRs).To(HaveLen(1))
+		Expect(*sizing[0].CRs[0].Replicas).To(BeEquivalentTo(5))
+		Expect(sizing[0].CRs[0].Limits.Memory).To(Equal("1Gi"))
+
+		By("clearing the status once the OperandConfig carries no sizing")
+		opcon := getTestOperandConfig(r, "common-service")
+		opcon.Object["spec"] = map[string]interface{}{"services": mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB: {}
+`)}
+		Expect(r.Client.Update(context.TODO(), opcon)).To(Succeed())
+		r.refreshEffectiveSizing(context.TODO(), nil)
+		Expect(getMasterSizing()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,40 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	utilyaml "github.com/ghodss/yaml"
+)
+
+// ExportOperandConfig runs the merge of all the CommonService CRs into the
+// OperandConfig without writing it, and returns the effective services as
+// YAML for the support bundles
+func (r *CommonServiceReconciler) ExportOperandConfig(ctx context.Context) ([]byte, error) {
+	_, services, _, err := r.mergeOperandConfig(ctx, nil, map[string]string{}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	servicesYAML, err := utilyaml.Marshal(services)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the OperandConfig services to yaml: %v", err)
+	}
+	return servicesYAML, nil
+}
@@ -0,0 +1,99 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExportOperandConfig", func() {
+	var r *CommonServiceReconciler
+
+	BeforeEach(func() {
+		cs := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+        resources:
+          limits:
+            cpu: "2"
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 500m
+          memory: 1Gi
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: mongodb-config
+    data:
+      data:
+        size: "1"
+- name: ibm-test-operator
+  spec:
+    testCR:
+      labels:
+        team: a
+`)), cs)
+	})
+
+	It("should export the merged services", func() {
+		exported, err := r.ExportOperandConfig(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		services, err := convertStringToSlice(string(exported))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: "2"
+          memory: 1Gi
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: mongodb-config
+    data:
+      data:
+        size: "1"
+- name: ibm-test-operator
+  spec:
+    testCR:
+      labels:
+        team: a
+`)))
+	})
+
+	It("should not write the OperandConfig", func() {
+		_, err := r.ExportOperandConfig(context.TODO())
+		Expect(err).NotTo(HaveOccurred())
+		spec := getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")
+		Expect(spec["replicas"]).To(BeEquivalentTo(1))
+	})
+})
@@ -0,0 +1,63 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+// IsolatedRuleKey marks the rule of an operator sized by the master
+// CommonService CR only, the other CRs don't influence its sizing
+const IsolatedRuleKey = "isolated"
+
+// getIsolatedOperators returns the names of the operators whose rule is marked
+// as isolated
+func getIsolatedOperators(ruleSlice []interface{}) map[string]bool {
+	isolated := map[string]bool{}
+	for _, rule := range ruleSlice {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, ok := ruleMap["name"].(string)
+		if !ok {
+			continue
+		}
+		if isIsolated, ok := ruleMap[IsolatedRuleKey].(bool); ok && isIsolated {
+			isolated[name] = true
+		}
+	}
+	return isolated
+}
+
+// splitIsolatedOperators splits the configs into the ones of the isolated
+// operators and the others
+func splitIsolatedOperators(configs []interface{}, isolated map[string]bool) ([]interface{}, []interface{}) {
+	if len(isolated) == 0 {
+		return nil, configs
+	}
+	var isolatedConfigs, others []interface{}
+	for _, config := range configs {
+		configMap, ok := config.(map[string]interface{})
+		if !ok {
+			others = append(others, config)
+			continue
+		}
+		if name, ok := configMap["name"].(string); ok && isolated[name] {
+			isolatedConfigs = append(isolatedConfigs, config)
+			continue
+		}
+		others = append(others, config)
+	}
+	return isolatedConfigs, others
+}
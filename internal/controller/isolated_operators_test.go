@@ -0,0 +1,129 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Isolated operators", func() {
+	var (
+		ruleSlice []interface{}
+		r         *CommonServiceReconciler
+	)
+	opconServices := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 500m
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`
+	expected := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 200m
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 3
+`
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  isolated: true
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+`)
+		master := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+        resources:
+          limits:
+            cpu: 200m
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        replicas: 2
+`)
+		tenant := newTestCommonServiceObject("tenant", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+        resources:
+          limits:
+            cpu: "1"
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        replicas: 3
+`)
+		r = newTestReconciler(master, tenant)
+	})
+
+	DescribeTable("should size the isolated operator by the master CR only",
+		func(extreme Extreme) {
+			services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, extreme)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(normalizeTestServices(services)[0]).To(Equal(normalizeTestServices(mustConvertStringToSlice(expected))[0]))
+		},
+		Entry("growing", Max),
+		Entry("shrinking", Min),
+	)
+
+	It("should size the other operators by the largest CR", func() {
+		services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(normalizeTestServices(services)).To(Equal(normalizeTestServices(mustConvertStringToSlice(expected))))
+	})
+
+	It("should split out the configs of the isolated operators", func() {
+		isolated, others := splitIsolatedOperators(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+- name: ibm-test-operator
+`), getIsolatedOperators(ruleSlice))
+		Expect(isolated).To(Equal([]interface{}{map[string]interface{}{"name": "ibm-im-mongodb-operator"}}))
+		Expect(others).To(Equal([]interface{}{map[string]interface{}{"name": "ibm-test-operator"}}))
+	})
+})
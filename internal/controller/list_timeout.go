@@ -0,0 +1,74 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// DefaultListTimeout bounds listing the CommonService CRs when
+// CSData.ListTimeout is not set
+const DefaultListTimeout = 30 * time.Second
+
+// ErrListTimeout is returned when listing the CommonService CRs doesn't finish
+// in time, e.g. on a degraded apiserver. The reconcile fails and is requeued
+// instead of blocking the worker.
+var ErrListTimeout = errors.New("timed out listing the CommonService CRs")
+
+func (r *CommonServiceReconciler) listTimeout() time.Duration {
+	if r.Bootstrap.CSData.ListTimeout > 0 {
+		return r.Bootstrap.CSData.ListTimeout
+	}
+	return DefaultListTimeout
+}
+
+// listCommonServices lists the CommonService CRs with a timeout derived from
+// the ctx. The call returns when the timeout expires even if the client
+// doesn't honor the ctx, the abandoned call is left to finish on its own.
+func (r *CommonServiceReconciler) listCommonServices(ctx context.Context, opts ...client.ListOption) (*apiv3.CommonServiceList, error) {
+	timeout := r.listTimeout()
+	listCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	csObjectList := &apiv3.CommonServiceList{}
+	done := make(chan error, 1)
+	go func() {
+		done <- r.Client.List(listCtx, csObjectList, opts...)
+	}()
+	select {
+	case err := <-done:
+		if err != nil && errors.Is(listCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return nil, fmt.Errorf("%w after %s: %v", ErrListTimeout, timeout, err)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return csObjectList, nil
+	case <-listCtx.Done():
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w after %s", ErrListTimeout, timeout)
+	}
+}
@@ -0,0 +1,76 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("getExtremeizes with a hung list", func() {
+	var (
+		r             *CommonServiceReconciler
+		opconServices []interface{}
+		release       chan struct{}
+	)
+
+	BeforeEach(func() {
+		opconServices = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`)
+		r = newTestReconciler(newTestOperandConfig(opconServices))
+		// Block the lists of the CommonService CRs until released, ignoring the
+		// ctx like a hung apiserver connection
+		release = make(chan struct{})
+		c := newHookClient(r)
+		c.list = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			if _, ok := list.(*apiv3.CommonServiceList); ok {
+				<-release
+			}
+			return c.Client.List(ctx, list, opts...)
+		}
+		r.Bootstrap.CSData.ListTimeout = 100 * time.Millisecond
+	})
+
+	AfterEach(func() {
+		close(release)
+	})
+
+	It("should give up the list after the timeout", func() {
+		start := time.Now()
+		_, err := r.getExtremeizes(context.TODO(), opconServices, nil, Max)
+		Expect(err).To(MatchError(ErrListTimeout))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+
+	It("should return the cancellation of the reconcile as it is", func() {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		_, err := r.getExtremeizes(ctx, opconServices, nil, Max)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(err).NotTo(MatchError(ErrListTimeout))
+	})
+})
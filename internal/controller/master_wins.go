@@ -0,0 +1,96 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+)
+
+// applyMasterConfigs assigns the keys set by the master CommonService CR into
+// the OperandConfig services, overriding the extreme sizes from other CRs. The
+// master configs are filtered by the rules like the other CRs.
+func applyMasterConfigs(logger logr.Logger, opconServices, masterConfigs, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, opconNs string) []interface{} {
+	masterSummary := mergeCSCRs(logger, nil, masterConfigs, ruleSlice, serviceControllerMappingSummary, opconNs, nil)
+
+	for _, opService := range opconServices {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			logger.Info("Skipping merging the operator, because it is not an object", "operator", opService)
+			continue
+		}
+		operatorName, ok := opServiceMap["name"].(string)
+		if !ok || operatorName == "" {
+			logger.Info("Skipping merging the operator, because its name is not a string", "operator", opServiceMap["name"])
+			continue
+		}
+		masterService, ok := getItemByName(masterSummary, operatorName).(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		opSpec, opSpecOk := opServiceMap["spec"].(map[string]interface{})
+		masterSpecs, masterSpecsOk := masterService["spec"].(map[string]interface{})
+		if opSpecOk && masterSpecsOk {
+			for cr, spec := range opSpec {
+				masterSpec, ok := masterSpecs[cr].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				specMap, ok := spec.(map[string]interface{})
+				if !ok {
+					logger.Info("Skipping merging the CR, because it is not an object in the OperandConfig", "operator", operatorName, "cr", cr)
+					continue
+				}
+				opSpec[cr] = mergeCRsIntoOperandConfigWithDefaultRules(logger, specMap, masterSpec, true)
+			}
+		} else if opServiceMap["spec"] != nil && !opSpecOk {
+			logger.Info("Skipping merging the spec, because it is not an object", "operator", operatorName)
+		}
+
+		opResources, ok := opServiceMap["resources"].([]interface{})
+		if !ok {
+			continue
+		}
+		masterResources, ok := masterService["resources"].([]interface{})
+		if !ok {
+			continue
+		}
+		for i, opResource := range opResources {
+			opResourceMap, ok := opResource.(map[string]interface{})
+			if !ok {
+				logger.Info("Skipping merging the resource, because it is not an object", "operator", operatorName, "resource", opResource)
+				continue
+			}
+			apiVersion, _ := opResourceMap["apiVersion"].(string)
+			kind, _ := opResourceMap["kind"].(string)
+			name, _ := opResourceMap["name"].(string)
+			namespace, _ := opResourceMap["namespace"].(string)
+			if apiVersion == "" || kind == "" || name == "" {
+				logger.Info("Skipping merging the resource, because apiVersion, kind or name is not set", "operator", operatorName, "apiVersion", apiVersion, "kind", kind, "name", name, "namespace", namespace)
+				continue
+			}
+			if namespace == "" {
+				namespace = opconNs
+			}
+			masterResource, ok := getItemByGVKNameNamespace(masterResources, opconNs, apiVersion, kind, name, namespace).(map[string]interface{})
+			if ok {
+				opResources[i] = mergeCRsIntoOperandConfigWithDefaultRules(logger, opResourceMap, masterResource, true)
+			}
+		}
+	}
+	return opconServices
+}
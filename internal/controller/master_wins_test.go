@@ -0,0 +1,86 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Master wins", func() {
+	var (
+		r         *CommonServiceReconciler
+		ruleSlice []interface{}
+	)
+
+	opconServices := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          cpu: 100m
+`
+	cpu := func(services []interface{}) interface{} {
+		return getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"]
+	}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+`)
+		master := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        resources:
+          limits:
+            cpu: 200m
+`)
+		tenant := newTestCommonServiceObject("tenant", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        resources:
+          limits:
+            cpu: 1000m
+`)
+		r = newTestReconciler(master, tenant)
+	})
+
+	It("should keep the largest cpu by default", func() {
+		services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cpu(services)).To(Equal("1000m"))
+	})
+
+	It("should let the smaller cpu of the master CR override the larger one", func() {
+		r.Bootstrap.CSData.MasterWinsEnable = true
+		services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cpu(services)).To(Equal("200m"))
+	})
+})
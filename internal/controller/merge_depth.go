@@ -0,0 +1,95 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"k8s.io/klog"
+)
+
+// DefaultMaxMergeDepth is the default maximum nesting depth of the configs
+// merged into the OperandConfig
+const DefaultMaxMergeDepth = 100
+
+// ErrMaxMergeDepth is returned when a config is nested deeper than the
+// maximum merge depth
+var ErrMaxMergeDepth = errors.New("exceeded the maximum merge depth")
+
+var (
+	maxMergeDepthLock sync.RWMutex
+	maxMergeDepth     = DefaultMaxMergeDepth
+)
+
+// SetMaxMergeDepth sets the maximum nesting depth of the merged configs, a
+// depth not above 0 falls back to the default
+func SetMaxMergeDepth(depth int) {
+	if depth <= 0 {
+		depth = DefaultMaxMergeDepth
+	}
+	maxMergeDepthLock.Lock()
+	defer maxMergeDepthLock.Unlock()
+	maxMergeDepth = depth
+}
+
+func getMaxMergeDepth() int {
+	maxMergeDepthLock.RLock()
+	defer maxMergeDepthLock.RUnlock()
+	return maxMergeDepth
+}
+
+// exceedsMergeDepth guards the recursive merges, the configs are validated
+// before merging, so it only stops the configs slipping past the validation
+func exceedsMergeDepth(key string, depth int) bool {
+	if max := getMaxMergeDepth(); depth > max {
+		klog.Errorf("Stopping the merge of key %s: %v %d", key, ErrMaxMergeDepth, max)
+		return true
+	}
+	return false
+}
+
+// validateMergeDepth checks the config is not nested deeper than the maximum
+// merge depth. It walks the config without recursion, so a deeply nested
+// config can't overflow the stack.
+func validateMergeDepth(config interface{}, what string) error {
+	type node struct {
+		value interface{}
+		depth int
+	}
+	max := getMaxMergeDepth()
+	stack := []node{{value: config, depth: 0}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if current.depth > max {
+			return fmt.Errorf("failed to merge %s: %w %d", what, ErrMaxMergeDepth, max)
+		}
+		switch value := current.value.(type) {
+		case map[string]interface{}:
+			for _, child := range value {
+				stack = append(stack, node{value: child, depth: current.depth + 1})
+			}
+		case []interface{}:
+			for _, child := range value {
+				stack = append(stack, node{value: child, depth: current.depth + 1})
+			}
+		}
+	}
+	return nil
+}
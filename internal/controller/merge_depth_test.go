@@ -0,0 +1,75 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newTestNestedMap(depth int) map[string]interface{} {
+	nested := map[string]interface{}{"replicas": int64(1)}
+	for i := 0; i < depth; i++ {
+		nested = map[string]interface{}{"nested": nested}
+	}
+	return nested
+}
+
+var _ = Describe("maximum merge depth", func() {
+	replicasPath := append(strings.Split(strings.Repeat("nested.", 20), ".")[:20], "replicas")
+
+	AfterEach(func() {
+		SetMaxMergeDepth(0)
+	})
+
+	It("should enforce the default depth without recursing into the config", func() {
+		Expect(validateMergeDepth(newTestNestedMap(100000), "the test config")).To(MatchError(ErrMaxMergeDepth))
+		Expect(validateMergeDepth(newTestNestedMap(DefaultMaxMergeDepth-1), "the test config")).To(Succeed())
+	})
+
+	It("should reject the OperandConfig nested deeper than the maximum depth", func() {
+		SetMaxMergeDepth(10)
+		opcon := newTestOperandConfig([]interface{}{
+			map[string]interface{}{
+				"name": "ibm-im-mongodb-operator",
+				"spec": newTestNestedMap(20),
+			},
+		})
+		r := newTestReconciler(opcon)
+		_, err := r.updateOperandConfig(context.TODO(), nil, map[string]string{"profileController": "default"})
+		Expect(err).To(MatchError(ErrMaxMergeDepth))
+	})
+
+	It("should stop the recursive merges at the maximum depth instead of descending", func() {
+		SetMaxMergeDepth(10)
+		changedMap := newTestNestedMap(20)
+		unstructured.RemoveNestedField(changedMap, replicasPath...)
+		changedMap = mergeSizeProfile(newTestNestedMap(20), changedMap)
+		_, found, _ := unstructured.NestedFieldNoCopy(changedMap, replicasPath...)
+		Expect(found).To(BeFalse())
+
+		By("descending without a maximum depth")
+		SetMaxMergeDepth(0)
+		changedMap = mergeSizeProfile(newTestNestedMap(20), changedMap)
+		replicas, _, _ := unstructured.NestedFieldNoCopy(changedMap, replicasPath...)
+		Expect(replicas).To(Equal(int64(1)))
+	})
+})
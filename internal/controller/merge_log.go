@@ -0,0 +1,90 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	"github.com/mohae/deepcopy"
+	"k8s.io/klog"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// MergeDecisionLogLevel is the default verbosity of the merge decision logs
+	MergeDecisionLogLevel klog.Level = 3
+	// MergeLogVerbosityRuleKey overrides the verbosity of the merge decision
+	// logs for a single operator in the rules, e.g. "logVerbosity: 0" always
+	// logs the decisions of the operator
+	MergeLogVerbosityRuleKey = "logVerbosity"
+)
+
+// mergeLogLevel returns the verbosity of the merge decision logs for the
+// operator of the rules
+func mergeLogLevel(rules interface{}) klog.Level {
+	if rules == nil {
+		return MergeDecisionLogLevel
+	}
+	verbosity, ok := rules.(map[string]interface{})[MergeLogVerbosityRuleKey]
+	if !ok {
+		return MergeDecisionLogLevel
+	}
+	switch verbosity := verbosity.(type) {
+	case float64:
+		return klog.Level(verbosity)
+	case int64:
+		return klog.Level(verbosity)
+	case int:
+		return klog.Level(verbosity)
+	}
+	klog.Warningf("Skipping %s %v in the rules of %v, because it is not a number", MergeLogVerbosityRuleKey, verbosity, rules.(map[string]interface{})["name"])
+	return MergeDecisionLogLevel
+}
+
+// snapshotForMergeLog copies the service before merging, so the decisions can
+// be logged afterwards. It returns nil when the decisions are not logged.
+func snapshotForMergeLog(service, rules interface{}) interface{} {
+	if !klog.V(mergeLogLevel(rules)) {
+		return nil
+	}
+	return deepcopy.Copy(service)
+}
+
+// logMergeDecisions logs the fields of the service changed by the merge stage
+func logMergeDecisions(stage string, existing, merged, rules interface{}) {
+	if existing == nil {
+		return
+	}
+	name, _ := existing.(map[string]interface{})["name"].(string)
+	for _, change := range diffLeaves(name, existing, merged, nil) {
+		klog.V(mergeLogLevel(rules)).Infof("Merge decision in %s: %s", stage, change.String())
+	}
+}
+
+// defaultMergeLogger returns the logger of the merges run outside of a
+// reconciler
+func defaultMergeLogger() logr.Logger {
+	return ctrl.Log.WithName("commonservice").WithName("merge")
+}
+
+// mergeLogger returns the logger of the merge pipeline, the reconcilers built
+// without a logger fall back to the default one
+func (r *CommonServiceReconciler) mergeLogger() logr.Logger {
+	if r.Log.GetSink() == nil {
+		return defaultMergeLogger()
+	}
+	return r.Log.WithName("merge")
+}
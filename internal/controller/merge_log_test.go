@@ -0,0 +1,232 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("Merge decision logs", func() {
+	var (
+		fs   *flag.FlagSet
+		logs bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		fs = flag.NewFlagSet("klog", flag.ContinueOnError)
+		klog.InitFlags(fs)
+		Expect(fs.Set("logtostderr", "false")).To(Succeed())
+		Expect(fs.Set("v", "2")).To(Succeed())
+		logs.Reset()
+		klog.SetOutput(&logs)
+	})
+
+	AfterEach(func() {
+		_ = fs.Set("logtostderr", "true")
+		_ = fs.Set("v", "0")
+		klog.SetOutput(os.Stderr)
+	})
+
+	It("should log the merge decisions at the verbosity of each operator", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  logVerbosity: 1
+  spec:
+    testA:
+      replicas: LARGEST_VALUE
+- name: ibm-test-b-operator
+  logVerbosity: 5
+  spec:
+    testB:
+      replicas: LARGEST_VALUE
+`)
+		template := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 1
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: 1
+`)
+		crs := []*unstructured.Unstructured{
+			newTestCommonService("common-service", `
+- services:
+  - name: ibm-test-a-operator
+    spec:
+      testA:
+        replicas: 2
+  - name: ibm-test-b-operator
+    spec:
+      testB:
+        replicas: 2
+`),
+		}
+
+		_, _, err := newTestReconciler().ReconcileFromSnapshot(context.TODO(), template, crs, ruleSlice)
+		Expect(err).NotTo(HaveOccurred())
+		klog.Flush()
+
+		Expect(logs.String()).To(ContainSubstring("Merge decision in OperandConfig update: changed ibm-test-a-operator.spec.testA.replicas: 1 -> 2"))
+		Expect(logs.String()).NotTo(ContainSubstring("Merge decision in OperandConfig update: changed ibm-test-b-operator"))
+	})
+})
+
+type recordedLog struct {
+	msg    string
+	values map[string]interface{}
+}
+
+// recordingLogSink records the messages and the key/value pairs of the logs
+type recordingLogSink struct {
+	mu      *sync.Mutex
+	entries *[]recordedLog
+	values  []interface{}
+}
+
+func newRecordingLogSink() *recordingLogSink {
+	return &recordingLogSink{mu: &sync.Mutex{}, entries: &[]recordedLog{}}
+}
+
+func (s *recordingLogSink) Init(logr.RuntimeInfo) {}
+
+func (s *recordingLogSink) Enabled(int) bool { return true }
+
+func (s *recordingLogSink) Info(_ int, msg string, keysAndValues ...interface{}) {
+	s.record(msg, keysAndValues)
+}
+
+func (s *recordingLogSink) Error(err error, msg string, keysAndValues ...interface{}) {
+	s.record(msg, append(keysAndValues, "error", err))
+}
+
+func (s *recordingLogSink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	values := append(append([]interface{}{}, s.values...), keysAndValues...)
+	return &recordingLogSink{mu: s.mu, entries: s.entries, values: values}
+}
+
+func (s *recordingLogSink) WithName(string) logr.LogSink { return s }
+
+func (s *recordingLogSink) record(msg string, keysAndValues []interface{}) {
+	values := map[string]interface{}{}
+	all := append(append([]interface{}{}, s.values...), keysAndValues...)
+	for i := 0; i+1 < len(all); i += 2 {
+		values[fmt.Sprint(all[i])] = all[i+1]
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.entries = append(*s.entries, recordedLog{msg: msg, values: values})
+}
+
+func (s *recordingLogSink) findAll(msg string) []recordedLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var entries []recordedLog
+	for _, entry := range *s.entries {
+		if strings.Contains(entry.msg, msg) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func (s *recordingLogSink) find(msg string) *recordedLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range *s.entries {
+		if strings.Contains((*s.entries)[i].msg, msg) {
+			return &(*s.entries)[i]
+		}
+	}
+	return nil
+}
+
+var _ = Describe("Merge logs", func() {
+	var (
+		r    *CommonServiceReconciler
+		sink *recordingLogSink
+	)
+
+	BeforeEach(func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`))
+		// The mongoDB config of the CR is not an object
+		cs := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB: oops
+`)
+		peer := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+`)
+		sink = newRecordingLogSink()
+		r = newTestReconciler(opcon, cs, peer)
+		r.Log = logr.New(sink)
+	})
+
+	It("should carry the operator and the CR in the logs of the merge", func() {
+		_, err := r.updateOperandConfig(context.TODO(), nil, map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+
+		entry := sink.find("Skipping the CommonService, because its configs failed to render")
+		Expect(entry).NotTo(BeNil())
+		Expect(entry.values["cr"]).To(Equal("example-service"))
+		Expect(entry.values["namespace"]).To(Equal("tenant-a"))
+		Expect(entry.values["extreme"]).To(Equal(Max))
+		var typeErr *SizeSpecTypeError
+		Expect(errors.As(entry.values["error"].(error), &typeErr)).To(BeTrue())
+		Expect(typeErr.Path).To(Equal("spec.services[0].spec.mongoDB"))
+	})
+
+	It("should carry the CR and its namespace in the logs of the deleted CommonService", func() {
+		csObject := &apiv3.CommonService{}
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Namespace: "tenant-b", Name: "example-service"}, csObject)).To(Succeed())
+		Expect(r.handleDelete(context.TODO(), csObject)).To(Succeed())
+
+		entry := sink.find("deleted CommonService")
+		Expect(entry).NotTo(BeNil())
+		Expect(entry.values["cr"]).To(Equal("example-service"))
+		Expect(entry.values["namespace"]).To(Equal("tenant-b"))
+		Expect(entry.values["operandConfig"]).To(Equal(testServicesNs + "/common-service"))
+	})
+})
@@ -0,0 +1,122 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-logr/logr"
+)
+
+// Provenance maps the path of each merged value, e.g.
+// "ibm-im-mongodb-operator.mongoDB.resources.limits.cpu", to the CommonService
+// CR the value comes from
+type Provenance map[string]string
+
+// mergeProvenance records the CommonService CR winning each comparison while
+// the configs of the CRs are summarized. A nil mergeProvenance records nothing,
+// so the merge functions take it unconditionally.
+type mergeProvenance struct {
+	provenance Provenance
+	// sources names the CR of each configs in the merged list
+	sources []string
+	source  string
+	path    string
+}
+
+// newMergeProvenance returns the recorder of the merge of the configs of the
+// CRs named by sources, in the same order
+func newMergeProvenance(sources []string) *mergeProvenance {
+	return &mergeProvenance{provenance: Provenance{}, sources: sources}
+}
+
+// forConfigs returns the recorder of the i-th configs of the merged list
+func (p *mergeProvenance) forConfigs(i int) *mergeProvenance {
+	if p == nil {
+		return nil
+	}
+	source := ""
+	if i < len(p.sources) {
+		source = p.sources[i]
+	}
+	return &mergeProvenance{provenance: p.provenance, sources: p.sources, source: source}
+}
+
+// child returns the recorder of the values under the key
+func (p *mergeProvenance) child(key string) *mergeProvenance {
+	if p == nil {
+		return nil
+	}
+	return &mergeProvenance{provenance: p.provenance, sources: p.sources, source: p.source, path: p.pathOf(key)}
+}
+
+func (p *mergeProvenance) pathOf(key string) string {
+	if p.path == "" {
+		return key
+	}
+	return p.path + "." + key
+}
+
+// record records the current CR as the source of the value of the key
+func (p *mergeProvenance) record(key string) {
+	if p == nil || p.source == "" {
+		return
+	}
+	p.provenance[p.pathOf(key)] = p.source
+}
+
+// recordAdded records the current CR as the source of the leaves under the
+// keys of the changed map missing from the default map, they are kept without
+// a comparison
+func (p *mergeProvenance) recordAdded(defaultMap, changedMap map[string]interface{}) {
+	if p == nil {
+		return
+	}
+	for key, value := range changedMap {
+		if _, ok := defaultMap[key]; ok {
+			continue
+		}
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			p.child(key).recordAdded(nil, valueMap)
+			continue
+		}
+		p.record(key)
+	}
+}
+
+// logProvenance logs the source CR of each merged value, sorted by the path
+func logProvenance(logger logr.Logger, provenance Provenance) {
+	paths := make([]string, 0, len(provenance))
+	for path := range provenance {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		logger.Info("Merged value provenance", "path", path, "source", provenance[path])
+	}
+}
+
+// MergeConfigsWithProvenance merges the configs like MergeConfigs, and also
+// returns the CommonService CR, named by sources in the order of
+// csConfigsList, whose value won the comparison for each merged value
+func MergeConfigsWithProvenance(opconServices []interface{}, csConfigsList [][]interface{}, sources []string, ruleSlice []interface{}, serviceControllerMapping map[string]string, extreme Extreme, opconNs string) ([]interface{}, Provenance) {
+	provenance := newMergeProvenance(sources)
+	// The background context is never cancelled
+	services, _ := extremeizeServices(context.Background(), defaultMergeLogger().WithValues("extreme", extreme), provenance, opconServices, csConfigsList, ruleSlice, serviceControllerMapping, extreme, opconNs, 1)
+	return services, provenance.provenance
+}
@@ -0,0 +1,106 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("MergeConfigsWithProvenance", func() {
+	var (
+		ruleSlice, opconServices []interface{}
+		csConfigsList            [][]interface{}
+	)
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+		opconServices = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 500m
+          memory: 1Gi
+`)
+		// Each CR wins the comparison of one key
+		csConfigsList = [][]interface{}{
+			mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: 200m
+          memory: 1Gi
+`),
+			mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 2
+      resources:
+        limits:
+          cpu: "2"
+          memory: 2Gi
+`),
+			mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 1500m
+          memory: 4Gi
+`),
+		}
+	})
+
+	It("should record the CR winning each key", func() {
+		sources := []string{"tenant-a/common-service", "tenant-b/common-service", "tenant-c/common-service"}
+		services, provenance := MergeConfigsWithProvenance(opconServices, csConfigsList, sources, ruleSlice, map[string]string{"profileController": "default"}, Max, testServicesNs)
+		Expect(provenance).To(Equal(Provenance{
+			"ibm-im-mongodb-operator.mongoDB.replicas":                "tenant-a/common-service",
+			"ibm-im-mongodb-operator.mongoDB.resources.limits.cpu":    "tenant-b/common-service",
+			"ibm-im-mongodb-operator.mongoDB.resources.limits.memory": "tenant-c/common-service",
+		}))
+
+		spec := getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+		Expect(spec["replicas"]).To(BeEquivalentTo(3))
+		limits, _, _ := unstructured.NestedMap(spec, "resources", "limits")
+		Expect(limits).To(Equal(map[string]interface{}{"cpu": "2", "memory": "4Gi"}))
+	})
+
+	It("should record nothing without the sources", func() {
+		_, provenance := MergeConfigsWithProvenance(opconServices, csConfigsList, nil, ruleSlice, map[string]string{"profileController": "default"}, Max, testServicesNs)
+		Expect(provenance).To(BeEmpty())
+	})
+})
@@ -0,0 +1,178 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("merge workers", func() {
+	var (
+		opconServices, ruleSlice, tenantA, tenantB strings.Builder
+		sequential                                 []interface{}
+	)
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(&opconServices, `
+- name: ibm-test-operator-%02[1]d
+  spec:
+    testCR:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 100m
+          memory: 128Mi
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: test-config-%02[1]d
+    data:
+      data:
+        replicas: 1
+`, i)
+		fmt.Fprintf(&ruleSlice, `
+- name: ibm-test-operator-%02d
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`, i)
+		fmt.Fprintf(&tenantA, `
+  - name: ibm-test-operator-%02[1]d
+    spec:
+      testCR:
+        replicas: %[2]d
+        resources:
+          limits:
+            cpu: %[2]d00m
+    resources:
+    - apiVersion: v1
+      kind: ConfigMap
+      name: test-config-%02[1]d
+      data:
+        data:
+          replicas: %[2]d
+`, i, i%3+1)
+		fmt.Fprintf(&tenantB, `
+  - name: ibm-test-operator-%02d
+    spec:
+      testCR:
+        resources:
+          limits:
+            memory: %dMi
+`, i, 64*(i%4+1))
+	}
+
+	merge := func(workers int) []interface{} {
+		rules := mustConvertStringToSlice(ruleSlice.String())
+		csA := newTestCommonServiceObject("tenant-a", "example-service", "- services:"+tenantA.String())
+		csB := newTestCommonServiceObject("tenant-b", "example-service", "- services:"+tenantB.String())
+		r := newTestReconciler(csA, csB)
+		r.Bootstrap.CSData.MergeWorkers = workers
+		services := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices.String()), mustConvertStringToSlice(tenantA.String()), rules, map[string]string{"profileController": "default"}, testServicesNs, workers)
+		services, err := r.getExtremeizes(context.TODO(), services, rules, Max)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		services, err = r.getExtremeizesWithout(context.TODO(), services, rules, Min, &types.NamespacedName{Namespace: "tenant-b", Name: "example-service"}, false)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return services
+	}
+
+	BeforeEach(func() {
+		sequential = merge(1)
+	})
+
+	It("should size the operators from both CRs", func() {
+		spec := getItemByName(sequential, "ibm-test-operator-05").(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})
+		Expect(spec["replicas"]).To(BeEquivalentTo(3))
+		Expect(spec["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"]).To(Equal("300m"))
+	})
+
+	DescribeTable("should match the sequential merge",
+		func(workers int) {
+			Expect(merge(workers)).To(Equal(sequential))
+		},
+		Entry("with 2 workers", 2),
+		Entry("with 4 workers", 4),
+		Entry("with 32 workers", 32),
+	)
+})
+
+var _ = Describe("merging with a cancelled context", func() {
+	var (
+		ctx           context.Context
+		mapping       = map[string]string{"profileController": "default"}
+		opconServices = `
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 1
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: 1
+`
+		newConfigs = `
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 2
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: 2
+`
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.TODO())
+		cancel()
+	})
+
+	DescribeTable("should stop the merges of the new configs with the error of the reconcile",
+		func(workers int) {
+			services, err := mergeNewConfigs(ctx, logr.Discard(), mustConvertStringToSlice(opconServices), mustConvertStringToSlice(newConfigs), nil, mapping, testServicesNs, workers, false)
+			Expect(err).To(MatchError(context.Canceled))
+			Expect(services).To(BeNil())
+		},
+		Entry("with 1 worker", 1),
+		Entry("with 2 workers", 2),
+	)
+
+	It("should stop the merge of the CRs", func() {
+		services, err := MergeConfigs(ctx, mustConvertStringToSlice(opconServices), [][]interface{}{mustConvertStringToSlice(newConfigs)}, nil, mapping, Max, testServicesNs)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(services).To(BeNil())
+	})
+
+	It("should leave the OperandConfig untouched", func() {
+		r := newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(opconServices)))
+		_, err := r.updateOperandConfig(ctx, mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-test-a-operator", "testA")["replicas"]).To(BeEquivalentTo(1))
+	})
+})
@@ -0,0 +1,100 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// rulesMergeTotal counts the CR specs merged into the OperandConfig with
+	// the rules defined in ConfigurationRules
+	rulesMergeTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "commonservice_operandconfig_rules_merge_total",
+			Help: "Number of CR specs merged into the OperandConfig using the rules from ConfigurationRules",
+		},
+		[]string{"operator"},
+	)
+	// defaultRulesMergeTotal counts the CR specs merged into the OperandConfig
+	// without any rule, it reveals the operators lacking rules
+	defaultRulesMergeTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "commonservice_operandconfig_default_rules_merge_total",
+			Help: "Number of CR specs merged into the OperandConfig using the default rules, because no rule is defined for the CR",
+		},
+		[]string{"operator"},
+	)
+	// cpuStripTotal counts the cpu limits stripped from the resources, because
+	// the operator is managed by a non-default profile controller
+	cpuStripTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "commonservice_operandconfig_cpu_limit_strip_total",
+			Help: "Number of cpu limits stripped from the OperandConfig resources, handing them off to a non-default profile controller",
+		},
+		[]string{"operator", "controller"},
+	)
+	// extremeizesDuration observes how long merging the configs of all the
+	// CommonService CRs takes
+	extremeizesDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "commonservice_extremeizes_duration_seconds",
+			Help:    "Duration of merging the configs of all the CommonService CRs into the OperandConfig services",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		},
+		[]string{"extreme"},
+	)
+	// operandConfigUpdatesTotal counts the OperandConfig updates performed,
+	// and the ones skipped because the merged services are unchanged
+	operandConfigUpdatesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "commonservice_operandconfig_updates_total",
+			Help: "Number of OperandConfig updates by result, updated or skipped when the merged services are unchanged",
+		},
+		[]string{"result"},
+	)
+	// resourcesMergeTotal counts the resources entries of the CommonService
+	// CRs merged, and the ones skipped because apiVersion, kind or name is
+	// not set
+	resourcesMergeTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "commonservice_operandconfig_resources_merge_total",
+			Help: "Number of resources entries from the CommonService CRs by result, merged or skipped because apiVersion, kind or name is not set",
+		},
+		[]string{"operator", "result"},
+	)
+	// commonServiceCRsProcessed is the number of CommonService CRs merged by
+	// the last reconcile
+	commonServiceCRsProcessed = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "commonservice_crs_processed",
+			Help: "Number of CommonService CRs merged into the OperandConfig by the last reconcile",
+		},
+	)
+)
+
+const (
+	// UpdateResultUpdated labels the OperandConfig updates performed
+	UpdateResultUpdated = "updated"
+	// UpdateResultSkipped labels the OperandConfig updates skipped
+	UpdateResultSkipped = "skipped"
+)
+
+func init() {
+	metrics.Registry.MustRegister(rulesMergeTotal, defaultRulesMergeTotal, cpuStripTotal, extremeizesDuration, operandConfigUpdatesTotal, resourcesMergeTotal, commonServiceCRsProcessed)
+}
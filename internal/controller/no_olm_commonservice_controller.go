@@ -238,6 +238,7 @@ func (r *CommonServiceReconciler) ReconcileNoOLMMasterCR(ctx context.Context, in
 	// Set "Pengding" condition and "Updating" for phase when config CS CR
 	instance.SetPendingCondition(constant.MasterCR, apiv3.ConditionTypeReconciling, corev1.ConditionTrue, apiv3.ConditionReasonConfig, apiv3.ConditionMessageConfig)
 	instance.Status.Phase = apiv3.CRUpdating
+	r.warnUnknownSizeKeys(cs)
 	newConfigs, serviceControllerMapping, statusErr := r.getNewConfigs(cs)
 	if statusErr != nil {
 		klog.Errorf("Fail to reconcile %s/%s: %v", instance.Namespace, instance.Name, statusErr)
@@ -251,14 +252,17 @@ func (r *CommonServiceReconciler) ReconcileNoOLMMasterCR(ctx context.Context, in
 	}
 
 	var isEqual bool
-	if isEqual, statusErr = r.updateOperandConfig(ctx, newConfigs, serviceControllerMapping); statusErr != nil {
+	if isEqual, statusErr = r.updateOperandConfigWithCondition(ctx, instance, newConfigs, serviceControllerMapping); statusErr != nil {
+		if result, ok := requeueOnOperandConfigNotFound(statusErr); ok {
+			return result, nil
+		}
 		if statusErr := r.updatePhase(ctx, instance, apiv3.CRFailed); statusErr != nil {
 			klog.Error(statusErr)
 		}
 		klog.Errorf("Fail to reconcile %s/%s: %v", instance.Namespace, instance.Name, statusErr)
 		return ctrl.Result{}, statusErr
 	} else if isEqual {
-		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, "common-service", instance.Namespace, instance.Name))
+		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, r.Bootstrap.CSData.OperandConfigName, instance.Namespace, instance.Name))
 	}
 
 	if statusErr = r.Bootstrap.UpdateEDBUserManaged(); statusErr != nil {
@@ -329,11 +333,15 @@ func (r *CommonServiceReconciler) ReconcileNoOLMGeneralCR(ctx context.Context, i
 	instance.UpdateNonMasterConfigStatus(&r.Bootstrap.CSData)
 
 	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
-	opconKey := types.NamespacedName{
-		Name:      "common-service",
-		Namespace: r.Bootstrap.CSData.ServicesNs,
+	opconKey, err := r.getOperandConfigKey()
+	if err != nil {
+		klog.Errorf("Fail to reconcile %s/%s: %v", instance.Namespace, instance.Name, err)
+		return ctrl.Result{}, err
 	}
 	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
+		if result, ok := requeueOnOperandConfigNotFound(wrapOperandConfigNotFound(opconKey, err)); ok {
+			return result, nil
+		}
 		klog.Errorf("failed to get OperandConfig %s: %v", opconKey.String(), err)
 		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
 			klog.Error(err)
@@ -357,6 +365,7 @@ func (r *CommonServiceReconciler) ReconcileNoOLMGeneralCR(ctx context.Context, i
 		return ctrl.Result{}, err
 	}
 
+	r.warnUnknownSizeKeys(cs)
 	newConfigs, serviceControllerMapping, err := r.getNewConfigs(cs)
 	if err != nil {
 		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
@@ -366,8 +375,11 @@ func (r *CommonServiceReconciler) ReconcileNoOLMGeneralCR(ctx context.Context, i
 		return ctrl.Result{}, err
 	}
 
-	isEqual, err := r.updateOperandConfig(ctx, newConfigs, serviceControllerMapping)
+	isEqual, err := r.updateOperandConfigWithCondition(ctx, instance, newConfigs, serviceControllerMapping)
 	if err != nil {
+		if result, ok := requeueOnOperandConfigNotFound(err); ok {
+			return result, nil
+		}
 		if err := r.updatePhase(ctx, instance, apiv3.CRFailed); err != nil {
 			klog.Error(err)
 		}
@@ -377,7 +389,7 @@ func (r *CommonServiceReconciler) ReconcileNoOLMGeneralCR(ctx context.Context, i
 
 	// Create Event if there is no update in OperandConfig after applying current CR
 	if isEqual {
-		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, "common-service", instance.Namespace, instance.Name))
+		r.Recorder.Event(instance, corev1.EventTypeNormal, "Noeffect", fmt.Sprintf("No update, resource sizings in the OperandConfig %s/%s are larger than the profile from CommonService CR %s/%s", r.Bootstrap.CSData.OperatorNs, r.Bootstrap.CSData.OperandConfigName, instance.Namespace, instance.Name))
 	}
 
 	isEqual, err = r.updateOperatorConfig(ctx, instance.Spec.OperatorConfigs)
@@ -0,0 +1,101 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"strings"
+
+	"k8s.io/klog"
+)
+
+// DeleteMarker is the value deleting the key from the OperandConfig, e.g.
+// "cpu: $delete" in the limits of a CR unsets the cpu limit so an external
+// autoscaler can take it over. Unlike null, which means no opinion unless
+// the null delete mode is enabled, the marker always deletes the key, and it
+// is never written into the OperandConfig.
+const DeleteMarker = "$delete"
+
+// collectNullPaths returns the paths of the spec keys set to the delete
+// marker in the new configs, e.g. [ibm-im-mongodb-operator spec mongoDB
+// replicas], and of the keys explicitly set to null when nullDelete is true.
+// The marked keys are removed from the new configs. It must be called before
+// merging, because the merge fills the null keys with the OperandConfig
+// values.
+func collectNullPaths(newConfigs []interface{}, nullDelete bool) [][]string {
+	var nullPaths [][]string
+	for _, newConfig := range newConfigs {
+		newConfigMap, ok := newConfig.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := newConfigMap["name"].(string)
+		spec, ok := newConfigMap["spec"].(map[string]interface{})
+		if name == "" || !ok {
+			continue
+		}
+		nullPaths = appendNullPaths(nullPaths, []string{name, "spec"}, spec, nullDelete)
+	}
+	return nullPaths
+}
+
+func appendNullPaths(nullPaths [][]string, path []string, m map[string]interface{}, nullDelete bool) [][]string {
+	for key, value := range m {
+		keyPath := append(append([]string{}, path...), key)
+		switch value := value.(type) {
+		case nil:
+			if nullDelete {
+				nullPaths = append(nullPaths, keyPath)
+			}
+		case string:
+			if value == DeleteMarker {
+				nullPaths = append(nullPaths, keyPath)
+				delete(m, key)
+			}
+		case map[string]interface{}:
+			nullPaths = appendNullPaths(nullPaths, keyPath, value, nullDelete)
+		}
+	}
+	return nullPaths
+}
+
+// deleteNullPaths deletes the keys set to null or the delete marker from the
+// OperandConfig services
+func deleteNullPaths(opconServices []interface{}, nullPaths [][]string) []interface{} {
+	for _, path := range nullPaths {
+		opService := getItemByName(opconServices, path[0])
+		if opService == nil {
+			continue
+		}
+		m := opService.(map[string]interface{})
+		for _, field := range path[1 : len(path)-1] {
+			next, ok := m[field].(map[string]interface{})
+			if !ok {
+				m = nil
+				break
+			}
+			m = next
+		}
+		if m == nil {
+			continue
+		}
+		if _, ok := m[path[len(path)-1]]; ok {
+			klog.Infof("Deleting %s from OperandConfig, because it is set to null or %s", strings.Join(path, "."), DeleteMarker)
+			delete(m, path[len(path)-1])
+		}
+	}
+	return opconServices
+}
@@ -0,0 +1,162 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+var _ = Describe("Null deletes", func() {
+	var (
+		r          *CommonServiceReconciler
+		newConfigs []interface{}
+		mapping    = map[string]string{"profileController": "default"}
+	)
+
+	limitsOf := func(spec map[string]interface{}) interface{} {
+		return spec["resources"].(map[string]interface{})["limits"]
+	}
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 100m
+          memory: 256Mi
+`)))
+		newConfigs = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+      resources:
+        limits:
+          cpu: null
+`)
+	})
+
+	It("should take the null value as no opinion by default", func() {
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		spec := getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-test-operator", "testCR")
+		Expect(limitsOf(spec)).To(Equal(map[string]interface{}{"cpu": "100m", "memory": "256Mi"}))
+	})
+
+	It("should delete the key of the null value when the mode is enabled", func() {
+		r.Bootstrap.CSData.NullDeleteEnable = true
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		spec := getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-test-operator", "testCR")
+		Expect(limitsOf(spec)).To(Equal(map[string]interface{}{"memory": "256Mi"}))
+		Expect(spec["replicas"]).To(BeEquivalentTo(2))
+	})
+})
+
+var _ = Describe("Null deletes from the CommonService", func() {
+	var (
+		opcon   *unstructured.Unstructured
+		mapping = map[string]string{"profileController": "default"}
+	)
+
+	limitsOf := func(r *CommonServiceReconciler) map[string]interface{} {
+		limits, _, _ := unstructured.NestedMap(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB"), "resources", "limits")
+		return limits
+	}
+
+	reconcile := func(r *CommonServiceReconciler, cs *apiv3.CommonService) {
+		csUnstructured := util.NewUnstructured("operator.ibm.com", "CommonService", "v3")
+		ExpectWithOffset(1, r.Client.Get(context.TODO(), types.NamespacedName{Namespace: cs.Namespace, Name: cs.Name}, csUnstructured)).To(Succeed())
+		newConfigs, _, err := r.getNewConfigs(csUnstructured)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		_, err = r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		opcon = newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: "1"
+          memory: 1Gi
+`))
+	})
+
+	It("should remove the cpu limit of the null cpu in the null delete mode", func() {
+		cs := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 2
+        resources:
+          limits:
+            cpu: null
+`)
+		r := newTestReconciler(opcon, cs.DeepCopy())
+		r.Bootstrap.CSData.NullDeleteEnable = true
+		reconcile(r, cs)
+		Expect(limitsOf(r)).To(Equal(map[string]interface{}{"memory": "1Gi"}))
+	})
+
+	It("should remove the cpu limit of the delete marker without the mode", func() {
+		cs := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        resources:
+          limits:
+            cpu: $delete
+`)
+		peer := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        resources:
+          limits:
+            cpu: "2"
+`)
+		r := newTestReconciler(opcon, cs.DeepCopy(), peer)
+		reconcile(r, cs)
+		Expect(limitsOf(r)).To(Equal(map[string]interface{}{"memory": "1Gi"}))
+
+		By("keeping the cpu limit removed though another CR requests it")
+		Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+		Expect(limitsOf(r)).To(Equal(map[string]interface{}{"memory": "1Gi"}))
+		serialized, err := json.Marshal(getTestOperandConfig(r, "common-service").Object["spec"])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(serialized)).NotTo(ContainSubstring(DeleteMarker))
+	})
+})
@@ -19,15 +19,21 @@ package controllers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	utilyaml "github.com/ghodss/yaml"
+	"github.com/go-logr/logr"
 	"github.com/mohae/deepcopy"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/klog"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
@@ -36,59 +42,145 @@ import (
 	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
 )
 
-var (
-	nonDefaultProfileController = map[string]int{
-		"turbo":      0,
-		"turbonomic": 0,
-		"vpa":        1,
-	}
-)
-
 type Extreme string
 
 const (
 	Max Extreme = "max"
 	Min Extreme = "min"
+	Avg Extreme = "avg"
+	Sum Extreme = "sum"
+	// Smallest summarizes the CRs by the smallest request, for the operators
+	// overriding their aggregation to min
+	Smallest Extreme = "smallest"
 )
 
-// mergeCRsIntoOperandConfig merges CRs by specific rules
-func mergeCRsIntoOperandConfig(defaultMap map[string]interface{}, changedMap map[string]interface{}, rules map[string]interface{}, overwrite, directAssign bool) map[string]interface{} {
+// OperatorIdentityKey is the key of the stable identity of an operator in the
+// services, the renamed operator is matched by it
+const OperatorIdentityKey = "identity"
+
+const (
+	// MergeStrategyKey is the key of the strategy merging the spec of an
+	// operator from the CommonService CR into the OperandConfig
+	MergeStrategyKey = "mergeStrategy"
+	// MergeStrategyReplace overwrites the OperandConfig spec of the operator
+	// with the spec of the CommonService CR
+	MergeStrategyReplace = "replace"
+
+	// LargestValueRule is the rule of the parameters merged by the largest
+	// size, they are the only ones shrunk on the resources
+	LargestValueRule = "LARGEST_VALUE"
+	// SmallestValueRule is the rule of the spec parameters merged by the
+	// smallest size, e.g. a minimum of idle connections, the smaller value of
+	// the CRs wins even when the OperandConfig is merged by the largest size
+	SmallestValueRule = "SMALLEST_VALUE"
+)
+
+// mergeCRsIntoOperandConfig merges CRs by specific rules. The path of the
+// changed map, e.g. "ibm-zen-operator.spec.zen", prefixes the keys filtered out.
+func mergeCRsIntoOperandConfig(logger logr.Logger, defaultMap map[string]interface{}, changedMap map[string]interface{}, rules map[string]interface{}, overwrite, directAssign bool, path string, provenance *mergeProvenance) map[string]interface{} {
 	if !overwrite {
 		for key := range changedMap {
 			// Remove the items not from the rules
-			filterChangedMapWithRules(key, changedMap[key], rules[key], changedMap)
+			filterChangedMapWithRules(logger, path, key, changedMap[key], rules[key], changedMap, 1)
 		}
 	}
+	provenance.recordAdded(defaultMap, changedMap)
 	for key := range defaultMap {
 		if reflect.DeepEqual(defaultMap[key], changedMap[key]) {
 			continue
 		}
 		// CR overwrites the existing OperandConfig
-		mergeChangedMap(key, defaultMap[key], changedMap[key], changedMap, directAssign)
+		mergeChangedMap(logger, "", key, defaultMap[key], changedMap[key], changedMap, rules[key], directAssign, provenance, 1)
 	}
 	return changedMap
 }
 
-// shrinkSize merges CRs by picking the smaller size
-func shrinkSize(defaultMap map[string]interface{}, changedMap map[string]interface{}, extreme Extreme) map[string]interface{} {
-	//TODO: Only shrink the parameter with `Largest_value` rule
+// shrinkSize merges CRs by picking the smaller size. The parameters with the
+// SMALLEST_VALUE rule in the rules of the CR are merged the other way round.
+// The summary of the CRs only carries the parameters with a rule, so the
+// parameters without one keep their value.
+func shrinkSize(logger logr.Logger, defaultMap map[string]interface{}, changedMap map[string]interface{}, ruleForCR map[string]interface{}, extreme Extreme) map[string]interface{} {
 	for key := range defaultMap {
 		if reflect.DeepEqual(defaultMap[key], changedMap[key]) {
 			continue
 		}
-		mergeChangedMapWithExtremeSize(key, defaultMap[key], changedMap[key], defaultMap, extreme)
+		mergeChangedMapWithExtremeSize(logger, key, defaultMap[key], changedMap[key], defaultMap, ruleForCR[key], extreme, 1)
 	}
 	return defaultMap
 }
 
+// childRule returns the rule of the key from the rule of its parent
+func childRule(rule interface{}, key string) interface{} {
+	ruleMap, _ := rule.(map[string]interface{})
+	return ruleMap[key]
+}
+
+// isSmallestValueRule reports whether the rule merges the parameter by the
+// smallest size
+func isSmallestValueRule(rule interface{}) bool {
+	ruleName, _ := rule.(string)
+	return ruleName == SmallestValueRule
+}
+
+// extremeForRule returns the extreme merging the parameter with the rule, the
+// SMALLEST_VALUE rule swaps the largest and the smallest sizes
+func extremeForRule(extreme Extreme, rule interface{}) Extreme {
+	if !isSmallestValueRule(rule) {
+		return extreme
+	}
+	switch extreme {
+	case Max:
+		return Min
+	case Min:
+		return Max
+	}
+	return extreme
+}
+
+// shrinkSizeWithRules merges the resource from the CRs by picking the smaller
+// size, only for the parameters with the LARGEST_VALUE rule. The parameters
+// without a rule keep their size, and the resource without any rule is left
+// untouched.
+func shrinkSizeWithRules(defaultMap map[string]interface{}, changedMap map[string]interface{}, rulesForResource map[string]interface{}) map[string]interface{} {
+	for key, rule := range rulesForResource {
+		if defaultMap[key] == nil || changedMap[key] == nil || reflect.DeepEqual(defaultMap[key], changedMap[key]) {
+			continue
+		}
+		switch rule := rule.(type) {
+		case map[string]interface{}:
+			defaultValue, ok := defaultMap[key].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			changedValue, ok := changedMap[key].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			defaultMap[key] = shrinkSizeWithRules(defaultValue, changedValue, rule)
+		case string:
+			if rule != LargestValueRule {
+				continue
+			}
+			_, defaultMap[key] = rules.ResourceComparison(defaultMap[key], changedMap[key])
+		}
+	}
+	return defaultMap
+}
+
+// mergeProfileController merges the profile controllers assigned by a CR into
+// the summary. An independent profile controller wins over the default CS
+// controller, and the one with the larger priority wins among them. The
+// controller already in the summary is kept on a tie.
 func mergeProfileController(serviceControllerMappingSummary, serviceControllerMapping map[string]string) map[string]string {
 	for operator, profileController := range serviceControllerMapping {
 		if summaryProfileController, ok := serviceControllerMappingSummary[operator]; ok {
-			// Independent profile controller has higher priority then default CS controller
-			if _, ok := nonDefaultProfileController[profileController]; ok {
-				if _, ok := nonDefaultProfileController[summaryProfileController]; !ok {
-					serviceControllerMappingSummary[operator] = profileController
-				}
+			priority, isNonDefault := profileControllerPriority(profileController)
+			if !isNonDefault {
+				continue
+			}
+			summaryPriority, isSummaryNonDefault := profileControllerPriority(summaryProfileController)
+			if !isSummaryNonDefault || priority > summaryPriority {
+				serviceControllerMappingSummary[operator] = profileController
 			}
 		} else {
 			serviceControllerMappingSummary[operator] = profileController
@@ -97,125 +189,232 @@ func mergeProfileController(serviceControllerMappingSummary, serviceControllerMa
 	return serviceControllerMappingSummary
 }
 
-func mergeCSCRs(csSummary, csCR, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, opconNs string) []interface{} {
+func mergeCSCRs(logger logr.Logger, csSummary, csCR, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, opconNs string, provenance *mergeProvenance) []interface{} {
 	for _, operator := range csCR {
-		summaryCR := getItemByName(csSummary, operator.(map[string]interface{})["name"].(string))
-		rules := getItemByName(ruleSlice, operator.(map[string]interface{})["name"].(string))
+		operatorMap, ok := operator.(map[string]interface{})
+		if !ok {
+			logger.Info("Skipping merging the operator, because it is not an object", "operator", operator)
+			continue
+		}
+		operatorName, ok := operatorMap["name"].(string)
+		if !ok || operatorName == "" {
+			logger.Info("Skipping merging the operator, because its name is not a string", "operator", operatorMap["name"])
+			continue
+		}
+		summaryCR := getItemByIdentity(csSummary, operator)
+		rules := getItemByIdentity(ruleSlice, operator)
 		if summaryCR == nil {
 			summaryCR = map[string]interface{}{
-				"name":      operator.(map[string]interface{})["name"].(string),
+				"name":      operatorName,
 				"spec":      map[string]interface{}{},
 				"resources": []interface{}{},
 			}
+			if identity, ok := operatorMap[OperatorIdentityKey]; ok {
+				summaryCR.(map[string]interface{})[OperatorIdentityKey] = identity
+			}
+			csSummary = append(csSummary, summaryCR)
 		} else if summaryCR.(map[string]interface{})["spec"] == nil {
 			summaryCR.(map[string]interface{})["spec"] = map[string]interface{}{}
 		} else if summaryCR.(map[string]interface{})["resources"] == nil {
 			summaryCR.(map[string]interface{})["resources"] = []interface{}{}
 		}
+		summaryName := summaryCR.(map[string]interface{})["name"].(string)
 		serviceController := serviceControllerMappingSummary["profileController"]
-		if controller, ok := serviceControllerMappingSummary[operator.(map[string]interface{})["name"].(string)]; ok {
+		if controller, ok := serviceControllerMappingSummary[operatorName]; ok {
 			serviceController = controller
 		}
-		if operator.(map[string]interface{})["spec"] != nil {
-			for cr, spec := range operator.(map[string]interface{})["spec"].(map[string]interface{}) {
-				if _, ok := nonDefaultProfileController[serviceController]; ok {
-					// clean up merged CS CR
-					operator.(map[string]interface{})["spec"].(map[string]interface{})[cr] = resetResourceInTemplate(spec.(map[string]interface{}), cr, rules)
-				}
-				if summaryCR.(map[string]interface{})["spec"].(map[string]interface{})[cr] == nil {
-					summaryCR.(map[string]interface{})["spec"].(map[string]interface{})[cr] = map[string]interface{}{}
-				}
-				if rules != nil && rules.(map[string]interface{})["spec"] != nil && rules.(map[string]interface{})["spec"].(map[string]interface{})[cr] != nil {
-					ruleForCR := rules.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
-					sizeForCR := summaryCR.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
-					summaryCR.(map[string]interface{})["spec"].(map[string]interface{})[cr] = mergeCRsIntoOperandConfig(sizeForCR, spec.(map[string]interface{}), ruleForCR, false, false)
+		if operatorMap["spec"] != nil {
+			operatorSpec, ok := operatorMap["spec"].(map[string]interface{})
+			summarySpec, summaryOk := summaryCR.(map[string]interface{})["spec"].(map[string]interface{})
+			if !ok || !summaryOk {
+				logger.Info("Skipping merging the spec, because it is not an object", "operator", operatorName)
+			} else {
+				for cr, spec := range operatorSpec {
+					specMap, ok := spec.(map[string]interface{})
+					if !ok {
+						logger.Info("Skipping merging the CR, because it is not an object", "operator", operatorName, "cr", cr)
+						continue
+					}
+					specMap = renameKeysInSpec(logger, specMap, cr, rules)
+					operatorSpec[cr] = specMap
+					if isNonDefaultProfileController(serviceController) {
+						// clean up merged CS CR
+						operatorSpec[cr] = resetResourceInTemplate(logger, specMap, cr, rules, serviceController)
+					}
+					sizeForCR, ok := summarySpec[cr].(map[string]interface{})
+					if !ok {
+						sizeForCR = map[string]interface{}{}
+						summarySpec[cr] = sizeForCR
+					}
+					if ruleForCR := getRuleForCR(rules, cr); ruleForCR != nil {
+						summarySpec[cr] = mergeCRsIntoOperandConfig(logger, sizeForCR, specMap, ruleForCR, false, false, operatorName+".spec."+cr, provenance.child(operatorName).child(cr))
+					}
 				}
+				csSummary = setSpecByName(csSummary, summaryName, summarySpec)
 			}
-			csSummary = setSpecByName(csSummary, operator.(map[string]interface{})["name"].(string), summaryCR.(map[string]interface{})["spec"])
 		}
 
 		// check if operator.(map[string]interface{})["resources"] is nil
-		if operator.(map[string]interface{})["resources"] != nil {
-			for i, opResource := range operator.(map[string]interface{})["resources"].([]interface{}) {
-				var apiVersion, kind, name, namespace string
-				if opResource.(map[string]interface{})["apiVersion"] != nil {
-					apiVersion = opResource.(map[string]interface{})["apiVersion"].(string)
-				}
-				if opResource.(map[string]interface{})["kind"] != nil {
-					kind = opResource.(map[string]interface{})["kind"].(string)
-				}
-				if opResource.(map[string]interface{})["name"] != nil {
-					name = opResource.(map[string]interface{})["name"].(string)
-				}
-				if opResource.(map[string]interface{})["namespace"] != nil {
-					namespace = opResource.(map[string]interface{})["namespace"].(string)
+		if operatorMap["resources"] != nil {
+			operatorResources, ok := operatorMap["resources"].([]interface{})
+			if !ok {
+				logger.Info("Skipping merging the resources, because they are not a list", "operator", operatorName)
+				continue
+			}
+			for i, opResource := range operatorResources {
+				opResourceMap, ok := opResource.(map[string]interface{})
+				if !ok {
+					logger.Info("Skipping merging the resource, because it is not an object", "operator", operatorName, "resource", opResource)
+					continue
 				}
+				apiVersion, _ := opResourceMap["apiVersion"].(string)
+				kind, _ := opResourceMap["kind"].(string)
+				name, _ := opResourceMap["name"].(string)
+				namespace, _ := opResourceMap["namespace"].(string)
 				// check if above 4 fields are all set
 				if apiVersion == "" || kind == "" || name == "" {
-					klog.Warningf("Skipping merging resource %s/%s/%s/%s, because apiVersion, kind or name is not set", apiVersion, kind, name, namespace)
+					logger.Info("Skipping merging the resource, because apiVersion, kind or name is not set", "operator", operatorName, "apiVersion", apiVersion, "kind", kind, "name", name, "namespace", namespace)
 					continue
 				}
 				// check if namespace is set, if not, set it to OperandConfig namespace
 				if namespace == "" {
 					namespace = opconNs
 				}
-				if summaryCR == nil || summaryCR.(map[string]interface{})["resources"] == nil {
+				summaryResources, ok := summaryCR.(map[string]interface{})["resources"].([]interface{})
+				if !ok {
 					continue
 				}
-				newResource := getItemByGVKNameNamespace(summaryCR.(map[string]interface{})["resources"].([]interface{}), opconNs, apiVersion, kind, name, namespace)
+				newResource := getItemByGVKNameNamespace(summaryResources, opconNs, apiVersion, kind, name, namespace)
 				if newResource != nil {
-					if _, ok := nonDefaultProfileController[serviceController]; ok {
-						if isOpResourceExists(newResource) {
-							klog.Info("### DEBUG: deleting key")
-							newResource.(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"] = struct{}{}
-						}
-					}
-					operator.(map[string]interface{})["resources"].([]interface{})[i] = mergeCRsIntoOperandConfigWithDefaultRules(opResource.(map[string]interface{}), newResource.(map[string]interface{}), false)
+					operatorResources[i] = mergeCRsIntoOperandConfigWithDefaultRules(logger, opResourceMap, newResource.(map[string]interface{}), false)
+					stripCPULimit(logger, operatorResources[i], operatorName, serviceController)
 				}
 			}
-			csSummary = setResByName(csSummary, operator.(map[string]interface{})["name"].(string), operator.(map[string]interface{})["resources"].([]interface{}))
+			csSummary = setResByName(csSummary, summaryName, operatorResources)
 		}
 	}
 	return csSummary
 }
 
+// getRuleForCR returns the rules of the CR from the rules of an operator
+func getRuleForCR(rules interface{}, cr string) map[string]interface{} {
+	rulesMap, ok := rules.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	rulesSpec, ok := rulesMap["spec"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	ruleForCR, _ := rulesSpec[cr].(map[string]interface{})
+	return ruleForCR
+}
+
 // mergeCRsIntoOperandConfig merges CRs by specific rules
-func mergeCRsIntoOperandConfigWithDefaultRules(defaultMap map[string]interface{}, changedMap map[string]interface{}, directAssign bool) map[string]interface{} {
+func mergeCRsIntoOperandConfigWithDefaultRules(logger logr.Logger, defaultMap map[string]interface{}, changedMap map[string]interface{}, directAssign bool) map[string]interface{} {
 	for key := range defaultMap {
 		if reflect.DeepEqual(defaultMap[key], changedMap[key]) {
 			continue
 		}
-		mergeChangedMap(key, defaultMap[key], changedMap[key], changedMap, directAssign)
+		mergeChangedMap(logger, "", key, defaultMap[key], changedMap[key], changedMap, nil, directAssign, nil, 1)
 	}
 	return changedMap
 }
 
-func filterChangedMapWithRules(key string, changedMap interface{}, rules interface{}, finalMap map[string]interface{}) {
+// renameKeysInSpec moves the friendly keys of a CR spec to the OperandConfig
+// paths declared in the "renames" section of the rules, e.g. a rule
+// "cpuLimit: resources.limits.cpu" merges cpuLimit into resources.limits.cpu
+func renameKeysInSpec(logger logr.Logger, spec map[string]interface{}, cr string, rules interface{}) map[string]interface{} {
+	if rules == nil {
+		return spec
+	}
+	renames, ok := rules.(map[string]interface{})["renames"].(map[string]interface{})
+	if !ok {
+		return spec
+	}
+	renamesForCR, ok := renames[cr].(map[string]interface{})
+	if !ok {
+		return spec
+	}
+	for friendlyKey, path := range renamesForCR {
+		value, found := spec[friendlyKey]
+		if !found {
+			continue
+		}
+		pathStr, ok := path.(string)
+		if !ok || pathStr == "" {
+			logger.Info("Skipping renaming the key, because the path is not a valid string", "cr", cr, "key", friendlyKey, "path", path)
+			continue
+		}
+		delete(spec, friendlyKey)
+		setNestedValue(spec, value, strings.Split(pathStr, "."))
+	}
+	return spec
+}
+
+// setNestedValue sets the value in the nested map by the given fields,
+// the missing or non-map intermediate fields are replaced by new maps
+func setNestedValue(m map[string]interface{}, value interface{}, fields []string) {
+	for _, field := range fields[:len(fields)-1] {
+		next, ok := m[field].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			m[field] = next
+		}
+		m = next
+	}
+	m[fields[len(fields)-1]] = value
+}
+
+// filterChangedMapWithRules removes the keys of the changed map no rule
+// permits. The path of the parent of the key names the removed keys in full in
+// the logs, e.g. "ibm-zen-operator.spec.zen.resources.cpu".
+func filterChangedMapWithRules(logger logr.Logger, path, key string, changedMap interface{}, rules interface{}, finalMap map[string]interface{}, depth int) {
+	if exceedsMergeDepth(key, depth) {
+		return
+	}
+	keyPath := key
+	if path != "" {
+		keyPath = path + "." + key
+	}
 	switch changedMap.(type) {
 	case map[string]interface{}:
 		//Check that the changed map value doesn't contain this map at all and is nil
 		if rules == nil {
+			logFilteredKey(logger, keyPath)
 			delete(finalMap, key)
 		} else {
 			if _, ok := rules.(map[string]interface{}); ok {
 				rulesRef := rules.(map[string]interface{})
 				changedMapRef := changedMap.(map[string]interface{})
 				for newKey := range changedMapRef {
-					filterChangedMapWithRules(newKey, changedMapRef[newKey], rulesRef[newKey], finalMap[key].(map[string]interface{}))
+					filterChangedMapWithRules(logger, keyPath, newKey, changedMapRef[newKey], rulesRef[newKey], finalMap[key].(map[string]interface{}), depth+1)
 				}
 			} else {
+				logFilteredKey(logger, keyPath)
 				delete(finalMap, key)
 			}
 		}
 	default:
 		if rules == nil && changedMap != nil {
-			klog.V(3).Info("delete " + key)
+			logFilteredKey(logger, keyPath)
 			delete(finalMap, key)
 		}
 	}
 }
 
-func mergeChangedMap(key string, defaultMap interface{}, changedMap interface{}, finalMap map[string]interface{}, directAssign bool) {
+// logFilteredKey logs the key dropped from the configs at the verbosity of
+// the merge decisions
+func logFilteredKey(logger logr.Logger, keyPath string) {
+	logger.V(int(MergeDecisionLogLevel)).Info("Dropping the key, because no rule permits it", "path", keyPath)
+}
+
+// mergeChangedMap merges the value of the key under the parent key from the
+// changed map into the final map, by the rule of the key
+func mergeChangedMap(logger logr.Logger, parentKey, key string, defaultMap interface{}, changedMap interface{}, finalMap map[string]interface{}, rule interface{}, directAssign bool, provenance *mergeProvenance, depth int) {
+	if exceedsMergeDepth(key, depth) {
+		return
+	}
 	if !reflect.DeepEqual(defaultMap, changedMap) {
 		switch defaultMap := defaultMap.(type) {
 		case map[string]interface{}:
@@ -225,8 +424,9 @@ func mergeChangedMap(key string, defaultMap interface{}, changedMap interface{},
 			} else if _, ok := changedMap.(map[string]interface{}); ok { //Check that the changed map value is also a map[string]interface
 				defaultMapRef := defaultMap
 				changedMapRef := changedMap.(map[string]interface{})
+				provenance.child(key).recordAdded(defaultMapRef, changedMapRef)
 				for newKey := range defaultMapRef {
-					mergeChangedMap(newKey, defaultMapRef[newKey], changedMapRef[newKey], finalMap[key].(map[string]interface{}), directAssign)
+					mergeChangedMap(logger, key, newKey, defaultMapRef[newKey], changedMapRef[newKey], finalMap[key].(map[string]interface{}), childRule(rule, newKey), directAssign, provenance.child(key), depth+1)
 				}
 			}
 		case []interface{}:
@@ -234,16 +434,25 @@ func mergeChangedMap(key string, defaultMap interface{}, changedMap interface{},
 			if changedMap == nil {
 				finalMap[key] = defaultMap
 			} else if _, ok := changedMap.([]interface{}); ok { //Check that the changed map value is also a []interface
+				// The items are merged by their name or id, and by index when
+				// they have none. Like the keys only in the changed map, the
+				// unmatched items of the changed array are kept as they are,
+				// and the unmatched items of the default array are appended.
 				defaultMapRef := defaultMap
 				changedMapRef := changedMap.([]interface{})
+				matches := matchSliceItems(defaultMapRef, changedMapRef)
 				for i := range defaultMapRef {
 					if _, ok := defaultMapRef[i].(map[string]interface{}); ok {
-						if len(changedMapRef) <= i {
-							finalMap[key] = append(finalMap[key].([]interface{}), defaultMapRef[i])
-						} else {
-
+						if matches[i] < 0 {
+							// The final slice is missing when the final map
+							// doesn't hold the changed array
+							finalSlice, _ := finalMap[key].([]interface{})
+							finalMap[key] = append(finalSlice, defaultMapRef[i])
+						} else if changedItem, ok := changedMapRef[matches[i]].(map[string]interface{}); ok {
+							itemProvenance := provenance.child(fmt.Sprintf("%s[%d]", key, matches[i]))
+							itemProvenance.recordAdded(defaultMapRef[i].(map[string]interface{}), changedItem)
 							for newKey := range defaultMapRef[i].(map[string]interface{}) {
-								mergeChangedMap(newKey, defaultMapRef[i].(map[string]interface{})[newKey], changedMapRef[i].(map[string]interface{})[newKey], finalMap[key].([]interface{})[i].(map[string]interface{}), directAssign)
+								mergeChangedMap(logger, key, newKey, defaultMapRef[i].(map[string]interface{})[newKey], changedItem[newKey], changedItem, childRule(rule, newKey), directAssign, itemProvenance, depth+1)
 							}
 						}
 					}
@@ -255,31 +464,50 @@ func mergeChangedMap(key string, defaultMap interface{}, changedMap interface{},
 				finalMap[key] = defaultMap
 			} else {
 				var comparableKeys = map[string]bool{
-					"replicas":        true,
-					"cpu":             true,
-					"memory":          true,
-					"profile":         true,
-					"fipsEnabled":     true,
-					"fips_enabled":    true,
-					"instances":       true,
-					"max_connections": true,
-					"shared_buffers":  true,
+					"replicas":          true,
+					"cpu":               true,
+					"memory":            true,
+					"profile":           true,
+					"fipsEnabled":       true,
+					"fips_enabled":      true,
+					"instances":         true,
+					"max_connections":   true,
+					"shared_buffers":    true,
+					"storage":           true,
+					"ephemeral-storage": true,
 				}
-				if _, ok := comparableKeys[key]; ok {
+				if _, ok := comparableKeys[key]; ok || isQuantityLeaf(parentKey, key, defaultMap, changedMap) || isSmallestValueRule(rule) {
 					if directAssign {
 						// Merge current CS CR into OperandConfig
 						finalMap[key] = changedMap
+						provenance.record(key)
+					} else if !isComparablePair(defaultMap, changedMap) {
+						logger.Info("Skipping merging the key, because the values can't be compared, keeping the default value", "key", key, "value", changedMap, "defaultValue", defaultMap)
+						finalMap[key] = defaultMap
 					} else {
-						finalMap[key], _ = rules.ResourceComparison(defaultMap, changedMap)
+						if isSmallestValueRule(rule) {
+							_, finalMap[key] = rules.ResourceComparison(defaultMap, changedMap)
+						} else {
+							finalMap[key], _ = rules.ResourceComparison(defaultMap, changedMap)
+						}
+						// The CR of the default value keeps the key when it wins
+						if !reflect.DeepEqual(finalMap[key], defaultMap) {
+							provenance.record(key)
+						}
 					}
-
+				} else {
+					// The value of the CR is kept without a comparison
+					provenance.record(key)
 				}
 			}
 		}
 	}
 }
 
-func mergeChangedMapWithExtremeSize(key string, defaultMap interface{}, changedMap interface{}, finalMap map[string]interface{}, extreme Extreme) {
+func mergeChangedMapWithExtremeSize(logger logr.Logger, key string, defaultMap interface{}, changedMap interface{}, finalMap map[string]interface{}, rule interface{}, extreme Extreme, depth int) {
+	if exceedsMergeDepth(key, depth) {
+		return
+	}
 	if !reflect.DeepEqual(defaultMap, changedMap) {
 		switch changedMap.(type) {
 		case map[string]interface{}:
@@ -287,28 +515,58 @@ func mergeChangedMapWithExtremeSize(key string, defaultMap interface{}, changedM
 				defaultMapRef := defaultMap.(map[string]interface{})
 				changedMapRef := changedMap.(map[string]interface{})
 				for newKey := range changedMapRef {
-					mergeChangedMapWithExtremeSize(newKey, defaultMapRef[newKey], changedMapRef[newKey], finalMap[key].(map[string]interface{}), extreme)
+					mergeChangedMapWithExtremeSize(logger, newKey, defaultMapRef[newKey], changedMapRef[newKey], finalMap[key].(map[string]interface{}), childRule(rule, newKey), extreme, depth+1)
 				}
 			}
 		case []interface{}:
 			if _, ok := defaultMap.([]interface{}); ok {
 				defaultMapRef := defaultMap.([]interface{})
 				changedMapRef := changedMap.([]interface{})
+				finalSlice, ok := finalMap[key].([]interface{})
+				if !ok {
+					return
+				}
 				for i := range changedMapRef {
-					for newKey := range changedMapRef[i].(map[string]interface{}) {
-						if _, ok := defaultMapRef[i].(map[string]interface{}); ok {
-							mergeChangedMapWithExtremeSize(newKey, defaultMapRef[i].(map[string]interface{})[newKey], changedMapRef[i].(map[string]interface{})[newKey], finalMap[key].([]interface{})[i].(map[string]interface{}), extreme)
-						}
+					// The items which are not objects, or are missing on
+					// either side, keep their value
+					changedItem, ok := changedMapRef[i].(map[string]interface{})
+					if !ok || i >= len(defaultMapRef) || i >= len(finalSlice) {
+						continue
+					}
+					defaultItem, ok := defaultMapRef[i].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					finalItem, ok := finalSlice[i].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					for newKey := range changedItem {
+						mergeChangedMapWithExtremeSize(logger, newKey, defaultItem[newKey], changedItem[newKey], finalItem, childRule(rule, newKey), extreme, depth+1)
 					}
 				}
 			}
 		default:
 			//Check if the value was set, otherwise set it
 			if changedMap != nil && defaultMap != nil {
-				if extreme == Max {
+				extreme = extremeForRule(extreme, rule)
+				if extreme == Avg || extreme == Smallest {
+					// The summary already carries the average or the smallest
+					// of all the CRs
+					finalMap[key] = changedMap
+				} else if !isComparablePair(defaultMap, changedMap) {
+					logger.Info("Skipping merging the key, because the values can't be compared, keeping the default value", "key", key, "value", changedMap, "defaultValue", defaultMap)
+				} else if extreme == Max {
 					finalMap[key], _ = rules.ResourceComparison(defaultMap, changedMap)
 				} else if extreme == Min {
 					_, finalMap[key] = rules.ResourceComparison(defaultMap, changedMap)
+				} else if extreme == Sum {
+					if summableKeys[key] {
+						// The summary already carries the sum of all the CRs
+						finalMap[key] = changedMap
+					} else {
+						finalMap[key], _ = rules.ResourceComparison(defaultMap, changedMap)
+					}
 				}
 			} else if changedMap != nil && defaultMap == nil {
 				finalMap[key] = changedMap
@@ -323,12 +581,15 @@ func mergeSizeProfile(defaultMap map[string]interface{}, changedMap map[string]i
 		if reflect.DeepEqual(defaultMap[key], changedMap[key]) {
 			continue
 		}
-		deepMergeTwoMaps(key, defaultMap[key], changedMap[key], changedMap)
+		deepMergeTwoMaps(key, defaultMap[key], changedMap[key], changedMap, 1)
 	}
 	return changedMap
 }
 
-func deepMergeTwoMaps(key string, defaultMap interface{}, changedMap interface{}, finalMap map[string]interface{}) {
+func deepMergeTwoMaps(key string, defaultMap interface{}, changedMap interface{}, finalMap map[string]interface{}, depth int) {
+	if exceedsMergeDepth(key, depth) {
+		return
+	}
 	switch defaultMap := defaultMap.(type) {
 	case map[string]interface{}:
 		//Check that the changed map value doesn't contain this map at all and is nil
@@ -338,7 +599,7 @@ func deepMergeTwoMaps(key string, defaultMap interface{}, changedMap interface{}
 			defaultMapRef := defaultMap
 			changedMapRef := changedMap.(map[string]interface{})
 			for newKey := range defaultMapRef {
-				deepMergeTwoMaps(newKey, defaultMapRef[newKey], changedMapRef[newKey], finalMap[key].(map[string]interface{}))
+				deepMergeTwoMaps(newKey, defaultMapRef[newKey], changedMapRef[newKey], finalMap[key].(map[string]interface{}), depth+1)
 			}
 		}
 	case []interface{}:
@@ -346,16 +607,18 @@ func deepMergeTwoMaps(key string, defaultMap interface{}, changedMap interface{}
 		if changedMap == nil {
 			finalMap[key] = defaultMap
 		} else if _, ok := changedMap.([]interface{}); ok { //Check that the changed map value is also a []interface
+			// The items are merged by their name or id, and by index when
+			// they have none
 			defaultMapRef := defaultMap
 			changedMapRef := changedMap.([]interface{})
+			matches := matchSliceItems(defaultMapRef, changedMapRef)
 			for i := range defaultMapRef {
 				if _, ok := defaultMapRef[i].(map[string]interface{}); ok {
-					if len(changedMapRef) <= i {
+					if matches[i] < 0 {
 						finalMap[key] = append(finalMap[key].([]interface{}), defaultMapRef[i])
-					} else {
-
+					} else if changedItem, ok := changedMapRef[matches[i]].(map[string]interface{}); ok {
 						for newKey := range defaultMapRef[i].(map[string]interface{}) {
-							deepMergeTwoMaps(newKey, defaultMapRef[i].(map[string]interface{})[newKey], changedMapRef[i].(map[string]interface{})[newKey], finalMap[key].([]interface{})[i].(map[string]interface{}))
+							deepMergeTwoMaps(newKey, defaultMapRef[i].(map[string]interface{})[newKey], changedItem[newKey], changedItem, depth+1)
 						}
 					}
 				}
@@ -369,311 +632,1041 @@ func deepMergeTwoMaps(key string, defaultMap interface{}, changedMap interface{}
 	}
 }
 
-func (r *CommonServiceReconciler) updateOperandConfig(ctx context.Context, newConfigs []interface{}, serviceControllerMapping map[string]string) (bool, error) {
-	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
-	opconKey := types.NamespacedName{
-		Name:      "common-service",
-		Namespace: r.Bootstrap.CSData.ServicesNs,
-	}
-	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
-		klog.Errorf("failed to get OperandConfig %s: %v", opconKey.String(), err)
-		return true, err
-	}
-
-	// Keep a version of existing config for comparison later
-	opconServices := opcon.Object["spec"].(map[string]interface{})["services"].([]interface{})
-	existingOpconServices := deepcopy.Copy(opconServices)
-
-	// Convert rules string to slice
-	ruleSlice, err := convertStringToSlice(rules.ConfigurationRules)
-	if err != nil {
-		return true, err
-	}
-
+// mergeNewConfigs merges the configs generated from a CommonService CR into
+// the OperandConfig services, the operators are merged concurrently by the
+// workers. It stops with the context error once the context is cancelled. The
+// merges are not counted in the metrics in dry run.
+func mergeNewConfigs(ctx context.Context, logger logr.Logger, opconServices, newConfigs, ruleSlice []interface{}, serviceControllerMapping map[string]string, opconNs string, workers int, dryRun bool) ([]interface{}, error) {
+	// The configs of the same operator are merged in their order by one worker
+	var operators []interface{}
+	var operatorConfigs [][]interface{}
+	operatorIndexes := map[string]int{}
 	for _, newConfigForOperator := range newConfigs {
 		if newConfigForOperator == nil {
 			continue
 		}
-		opService := getItemByName(opconServices, newConfigForOperator.(map[string]interface{})["name"].(string))
+		opService := getItemByIdentity(opconServices, newConfigForOperator)
 		if opService == nil {
 			continue
 		}
+		i, ok := operatorIndexes[getIdentity(opService)]
+		if !ok {
+			i = len(operators)
+			operatorIndexes[getIdentity(opService)] = i
+			operators = append(operators, opService)
+			operatorConfigs = append(operatorConfigs, nil)
+		}
+		operatorConfigs[i] = append(operatorConfigs[i], newConfigForOperator)
+	}
+
+	merge := func(opService, newConfigForOperator interface{}) {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			return
+		}
+		newConfigMap, ok := newConfigForOperator.(map[string]interface{})
+		if !ok {
+			logger.Info("Skipping merging the config, because it is not an object", "config", newConfigForOperator)
+			return
+		}
+		operatorName, _ := opServiceMap["name"].(string)
 		serviceController := serviceControllerMapping["profileController"]
-		if controller, ok := serviceControllerMapping[newConfigForOperator.(map[string]interface{})["name"].(string)]; ok {
-			serviceController = controller
+		if newConfigName, ok := newConfigMap["name"].(string); ok {
+			if controller, ok := serviceControllerMapping[newConfigName]; ok {
+				serviceController = controller
+			}
 		}
 		// Fetch newConfigForOperator and rules for an operator
-		rules := getItemByName(ruleSlice, opService.(map[string]interface{})["name"].(string))
-
-		if opService.(map[string]interface{})["spec"] != nil && newConfigForOperator.(map[string]interface{})["spec"] != nil {
-			for cr, spec := range opService.(map[string]interface{})["spec"].(map[string]interface{}) {
-				if _, ok := nonDefaultProfileController[serviceController]; ok {
+		rules := getItemByIdentity(ruleSlice, opService)
+		existingService := snapshotForMergeLog(opService, rules)
+
+		newSpec, newSpecOk := newConfigMap["spec"].(map[string]interface{})
+		if newSpecOk && newConfigMap[MergeStrategyKey] == MergeStrategyReplace {
+			// The curated spec of the CR replaces the OperandConfig spec as is
+			logger.V(2).Info("Replacing the spec in OperandConfig with the CommonService CR", "operator", operatorName)
+			opServiceMap["spec"] = deepcopy.Copy(newSpec)
+		} else if opSpec, ok := opServiceMap["spec"].(map[string]interface{}); ok && newSpecOk {
+			for cr, spec := range opSpec {
+				specMap, ok := spec.(map[string]interface{})
+				if !ok {
+					logger.Info("Skipping merging the CR, because it is not an object in the OperandConfig", "operator", operatorName, "cr", cr)
+					continue
+				}
+				if isNonDefaultProfileController(serviceController) {
 					// clean up OperandConfig
-					opService.(map[string]interface{})["spec"].(map[string]interface{})[cr] = resetResourceInTemplate(spec.(map[string]interface{}), cr, rules)
+					specMap = resetResourceInTemplate(logger, specMap, cr, rules, serviceController)
+					opSpec[cr] = specMap
 				}
 
-				if newConfigForOperator.(map[string]interface{})["spec"].(map[string]interface{})[cr] == nil {
+				if newSpec[cr] == nil {
+					continue
+				}
+				newConfigForCR, ok := newSpec[cr].(map[string]interface{})
+				if !ok {
+					logger.Info("Skipping merging the CR, because it is not an object in the CommonService", "operator", operatorName, "cr", cr)
 					continue
 				}
-				newConfigForCR := newConfigForOperator.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
+				newConfigForCR = renameKeysInSpec(logger, newConfigForCR, cr, rules)
 
 				overwrite := true
-				if rules != nil && rules.(map[string]interface{})["spec"] != nil && rules.(map[string]interface{})["spec"].(map[string]interface{})[cr] != nil {
-					ruleForCR := rules.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
-					opService.(map[string]interface{})["spec"].(map[string]interface{})[cr] = mergeCRsIntoOperandConfig(spec.(map[string]interface{}), newConfigForCR, ruleForCR, overwrite, true)
+				if ruleForCR := getRuleForCR(rules, cr); ruleForCR != nil {
+					if !dryRun {
+						rulesMergeTotal.WithLabelValues(operatorName).Inc()
+					}
+					opSpec[cr] = mergeCRsIntoOperandConfig(logger, specMap, newConfigForCR, ruleForCR, overwrite, true, operatorName+".spec."+cr, nil)
 				} else {
 					if overwrite {
-						opService.(map[string]interface{})["spec"].(map[string]interface{})[cr] = mergeCRsIntoOperandConfigWithDefaultRules(spec.(map[string]interface{}), newConfigForCR, false)
+						if !dryRun {
+							defaultRulesMergeTotal.WithLabelValues(operatorName).Inc()
+						}
+						opSpec[cr] = mergeCRsIntoOperandConfigWithDefaultRules(logger, specMap, newConfigForCR, false)
 					}
 				}
 			}
 		}
 
-		if opService.(map[string]interface{})["resources"] != nil {
-			if opResources, ok := opService.(map[string]interface{})["resources"].([]interface{}); ok {
+		if opServiceMap["resources"] != nil {
+			if opResources, ok := opServiceMap["resources"].([]interface{}); ok {
 				for i, opResource := range opResources {
 					// get resource by checking apiVersion, kind, name, namespace
-					var apiVersion, kind, name, namespace string
-					if opResource.(map[string]interface{})["apiVersion"] != nil {
-						apiVersion = opResource.(map[string]interface{})["apiVersion"].(string)
-					}
-					if opResource.(map[string]interface{})["kind"] != nil {
-						kind = opResource.(map[string]interface{})["kind"].(string)
-					}
-					if opResource.(map[string]interface{})["name"] != nil {
-						name = opResource.(map[string]interface{})["name"].(string)
-					}
-					if opResource.(map[string]interface{})["namespace"] != nil {
-						namespace = opResource.(map[string]interface{})["namespace"].(string)
+					opResourceMap, ok := opResource.(map[string]interface{})
+					if !ok {
+						logger.Info("Skipping merging the resource, because it is not an object", "operator", operatorName, "resource", opResource)
+						continue
 					}
+					apiVersion, _ := opResourceMap["apiVersion"].(string)
+					kind, _ := opResourceMap["kind"].(string)
+					name, _ := opResourceMap["name"].(string)
+					namespace, _ := opResourceMap["namespace"].(string)
 					// check if above 4 fields are all set
 					if apiVersion == "" || kind == "" || name == "" {
-						klog.Warningf("Skipping merging resource %s/%s/%s/%s, because apiVersion, kind or name is not set", apiVersion, kind, name, namespace)
+						logger.Info("Skipping merging the resource, because apiVersion, kind or name is not set", "operator", operatorName, "apiVersion", apiVersion, "kind", kind, "name", name, "namespace", namespace)
 						continue
 					}
 					// check if namespace is set, if not, set it to OperandConfig namespace
 					if namespace == "" {
-						namespace = opconKey.Namespace
+						namespace = opconNs
 					}
 
-					if newConfigForOperator.(map[string]interface{})["resources"] == nil {
+					newResources, ok := newConfigMap["resources"].([]interface{})
+					if !ok {
 						continue
 					}
 
-					newResource := getItemByGVKNameNamespace(newConfigForOperator.(map[string]interface{})["resources"].([]interface{}), opconKey.Namespace, apiVersion, kind, name, namespace)
-					if newResource != nil {
-						if _, ok := nonDefaultProfileController[serviceController]; ok {
-							if isOpResourceExists(newResource) {
-								klog.Info("### DEBUG: deleting key")
-								newResource.(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"] = struct{}{}
-							}
-						}
-						opResources[i] = mergeCRsIntoOperandConfigWithDefaultRules(opResource.(map[string]interface{}), newResource.(map[string]interface{}), true)
+					newResource, ok := getItemByGVKNameNamespace(newResources, opconNs, apiVersion, kind, name, namespace).(map[string]interface{})
+					if ok {
+						opResources[i] = mergeCRsIntoOperandConfigWithDefaultRules(logger, opResourceMap, newResource, true)
+						stripCPULimit(logger, opResources[i], operatorName, serviceController)
 					}
 				}
-				opService.(map[string]interface{})["resources"] = opResources
+				opServiceMap["resources"] = opResources
 			}
 		}
-
+		logMergeDecisions("OperandConfig update", existingService, opService, rules)
 	}
-
-	// Checking all the common service CRs to get the minimal(unique largest) size
-	opconServices, err = r.getExtremeizes(ctx, opconServices, ruleSlice, Max)
+	err := forEachOperator(ctx, workers, len(operators), func(i int) {
+		for _, newConfigForOperator := range operatorConfigs[i] {
+			merge(operators[i], newConfigForOperator)
+		}
+	})
 	if err != nil {
+		return nil, err
+	}
+	return opconServices, nil
+}
+
+func (r *CommonServiceReconciler) updateOperandConfig(ctx context.Context, newConfigs []interface{}, serviceControllerMapping map[string]string) (bool, error) {
+	isEqual, _, err := r.updateOperandConfigWithChanges(ctx, newConfigs, serviceControllerMapping)
+	return isEqual, err
+}
+
+// updateOperandConfigWithCondition updates the OperandConfig with the configs
+// of the CommonService CR, and sets the ConfigMerged condition of the CR from
+// the result, or to pending while the configs wait for the bulk merge. The
+// condition is persisted with the phase of the CR.
+func (r *CommonServiceReconciler) updateOperandConfigWithCondition(ctx context.Context, instance *apiv3.CommonService, newConfigs []interface{}, serviceControllerMapping map[string]string) (bool, error) {
+	// The terminating CR is already removed from the aggregate, merging its
+	// configs would flip its sizing in and out of the OperandConfig until the
+	// finalizers let it go
+	key := types.NamespacedName{Namespace: instance.Namespace, Name: instance.Name}
+	logger := r.mergeLogger().WithValues("cr", instance.Name, "namespace", instance.Namespace)
+	if instance.GetDeletionTimestamp() != nil {
+		logger.Info("The CommonService is terminating, removing its sizing from the OperandConfig")
+		if r.bulkMerge != nil {
+			r.bulkMerge.Forget(key)
+		}
+		err := r.handleDelete(ctx, instance)
+		instance.SetConfigMergedCondition(err)
+		if err == nil {
+			r.refreshEffectiveSizing(ctx, instance)
+		}
 		return true, err
 	}
 
-	// Compare to see whether new resource sizing is introduced into opconServices
+	// The isolated operators are sized by the master CR only
+	if !r.checkNamespace(key.String()) {
+		ruleSlice, err := getConfigurationRules()
+		if err != nil {
+			instance.SetConfigMergedCondition(err)
+			return true, err
+		}
+		_, newConfigs = splitIsolatedOperators(newConfigs, getIsolatedOperators(ruleSlice))
+	}
+
+	// The merge pass over all the CRs picks the configs of the CR up, along
+	// with the other CRs changed in the burst. The condition is pending until
+	// a pass has merged the current configs of the CR.
+	if r.bulkMerge != nil {
+		configs := commonServiceConfigs{configs: newConfigs, mapping: serviceControllerMapping}
+		merged, err := r.bulkMerge.Result(key, configs)
+		if !merged {
+			logger.V(2).Info("Triggering the bulk merge for the CommonService")
+			r.bulkMerge.Request(key, configs)
+			instance.SetConfigMergePendingCondition()
+			return false, nil
+		}
+		instance.SetConfigMergedCondition(err)
+		instance.SetProfileControllerConflictCondition(getProfileControllerConflicts(key))
+		return false, err
+	}
+
+	isEqual, err := r.updateOperandConfig(ctx, newConfigs, serviceControllerMapping)
+	instance.SetConfigMergedCondition(err)
+	if err == nil {
+		r.refreshEffectiveSizing(ctx, instance)
+	}
+	instance.SetProfileControllerConflictCondition(getProfileControllerConflicts(key))
+	return isEqual, err
+}
+
+// updateOperandConfigWithChanges updates the OperandConfig like
+// updateOperandConfig, and also returns the names of the operators whose spec
+// or resources are changed, so only the affected operands are refreshed
+func (r *CommonServiceReconciler) updateOperandConfigWithChanges(ctx context.Context, newConfigs []interface{}, serviceControllerMapping map[string]string) (bool, []string, error) {
+	isEqual, _, changedOperators, err := r.mergeOperandConfig(ctx, newConfigs, serviceControllerMapping, false)
+	return isEqual, changedOperators, err
+}
+
+// commonServiceConfigs holds the configs generated from a CommonService CR
+// and its mapping of the profile controllers
+type commonServiceConfigs struct {
+	configs []interface{}
+	mapping map[string]string
+}
+
+// mergeOperandConfig merges the new configs and the CommonService CRs into the
+// OperandConfig. In dry run, the merged services are returned for preview
+// without writing the OperandConfig.
+func (r *CommonServiceReconciler) mergeOperandConfig(ctx context.Context, newConfigs []interface{}, serviceControllerMapping map[string]string, dryRun bool) (bool, []interface{}, []string, error) {
+	return r.mergeOperandConfigs(ctx, []commonServiceConfigs{{configs: newConfigs, mapping: serviceControllerMapping}}, dryRun, false)
+}
+
+// mergeOperandConfigs merges the configs of the CRs in their order and the
+// CommonService CRs into the OperandConfig. With recompute, the sizing no CR
+// requests anymore is shrunk before the same update. The merge is retried on
+// the OperandConfig fetched again when the update conflicts with another
+// writer, and the merges of the operator itself are serialized.
+func (r *CommonServiceReconciler) mergeOperandConfigs(ctx context.Context, configsList []commonServiceConfigs, dryRun, recompute bool) (bool, []interface{}, []string, error) {
+	if !dryRun {
+		opconKey, err := r.getOperandConfigKey()
+		if err != nil {
+			return true, nil, nil, err
+		}
+		defer lockOperandConfig(opconKey)()
+	}
+
 	isEqual := true
-	for _, opService := range opconServices {
-		existingOpService := getItemByName(existingOpconServices.([]interface{}), opService.(map[string]interface{})["name"].(string))
-		if opService.(map[string]interface{})["spec"] == nil {
-			continue
+	var opconServices []interface{}
+	var changedOperators []string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var err error
+		// The merge modifies the new configs, every attempt starts from a copy
+		attempt := make([]commonServiceConfigs, len(configsList))
+		for i, configs := range configsList {
+			attempt[i] = commonServiceConfigs{configs: copyConfigs(configs.configs), mapping: configs.mapping}
 		}
-		for cr, spec := range opService.(map[string]interface{})["spec"].(map[string]interface{}) {
-			existingCrSpec := existingOpService.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
-			if isEqual = rules.ResourceEqualComparison(existingCrSpec, spec); !isEqual {
-				break
-			}
+		isEqual, opconServices, changedOperators, err = r.mergeOperandConfigOnce(ctx, attempt, dryRun, recompute)
+		return err
+	})
+	if err != nil {
+		return true, nil, nil, err
+	}
+	return isEqual, opconServices, changedOperators, nil
+}
+
+func (r *CommonServiceReconciler) mergeOperandConfigOnce(ctx context.Context, configsList []commonServiceConfigs, dryRun, recompute bool) (bool, []interface{}, []string, error) {
+	opconKey, err := r.getOperandConfigKey()
+	if err != nil {
+		return true, nil, nil, err
+	}
+	logger := r.mergeLogger().WithValues("operandConfig", opconKey.String())
+	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
+		if err := wrapOperandConfigNotFound(opconKey, err); errors.Is(err, ErrOperandConfigNotFound) {
+			logger.Info("Skipping the merge, because the OperandConfig is not found yet")
+			return true, nil, nil, err
 		}
-		if !isEqual {
-			break
+		logger.Error(err, "Failed to get the OperandConfig")
+		return true, nil, nil, err
+	}
+
+	// Keep a version of existing config for comparison later
+	opconServices, err := getOperandConfigServices(opcon)
+	if err != nil {
+		return true, nil, nil, err
+	}
+	existingOpconServices := deepcopy.Copy(opconServices)
+
+	// Convert rules string to slice
+	ruleSlice, err := getConfigurationRules()
+	if err != nil {
+		return true, nil, nil, err
+	}
+
+	var nullPaths [][]string
+	for _, configs := range configsList {
+		if err := validateMergeDepth(configs.configs, "the CommonService configs"); err != nil {
+			logger.Error(err, "Failed to merge the CommonService configs into the OperandConfig")
+			return true, nil, nil, err
 		}
+		// Collect the keys set to null or the delete marker before merging
+		// fills them
+		nullPaths = append(nullPaths, collectNullPaths(configs.configs, r.Bootstrap.CSData.NullDeleteEnable)...)
 	}
 
-	opcon.Object["spec"].(map[string]interface{})["services"] = opconServices
+	for _, configs := range configsList {
+		opconServices, err = mergeNewConfigs(ctx, logger, opconServices, configs.configs, ruleSlice, configs.mapping, opconKey.Namespace, r.Bootstrap.CSData.MergeWorkers, dryRun)
+		if err != nil {
+			return true, nil, nil, err
+		}
+	}
 
-	if err := r.Update(ctx, opcon); err != nil {
-		klog.Errorf("failed to update OperandConfig %s: %v", opconKey.String(), err)
-		return true, err
+	// Checking all the common service CRs to get the minimal(unique largest) size
+	extreme := Max
+	if r.Bootstrap.CSData.SumReplicasEnable {
+		// The replicas scale with the number of the CRs requesting them
+		extreme = Sum
+	}
+	opconServices, err = r.getExtremeizesWithout(ctx, opconServices, ruleSlice, extreme, nil, dryRun)
+	if err != nil {
+		return true, nil, nil, err
+	}
+	opconServices = deleteNullPaths(opconServices, nullPaths)
+	// The overlay of the platform wins over the sizing of all the CRs
+	opconServices, err = r.overlaySizing(ctx, logger, opconServices)
+	if err != nil {
+		return true, nil, nil, err
+	}
+	if r.Bootstrap.CSData.PruneOrphanedResources {
+		opconServices = r.pruneOrphanedResources(ctx, logger, opconServices, opconKey.Namespace)
 	}
+	opconServices = r.transformServices(logger, opconServices)
+	sortServicesByName(opconServices)
 
-	return isEqual, nil
+	if recompute {
+		opconServices, err = r.shrinkOperandConfigServices(ctx, logger, opconKey, opconServices, nil, nil)
+		if err != nil {
+			return true, nil, nil, err
+		}
+	}
+
+	// Compare to see whether new resource sizing is introduced into opconServices
+	isEqual := specsEqual(existingOpconServices.([]interface{}), opconServices)
+
+	changedOperators := getChangedOperators(existingOpconServices.([]interface{}), opconServices)
+
+	if dryRun {
+		logger.Info("Dry run, skipping updating the OperandConfig")
+		return isEqual, opconServices, changedOperators, nil
+	}
+
+	if isOperandConfigFrozen(opcon) {
+		logger.Info("The OperandConfig is frozen, skipping the update", "annotation", FreezeAnnoKey, "changedOperators", changedOperators)
+		operandConfigUpdatesTotal.WithLabelValues(UpdateResultSkipped).Inc()
+		return isEqual, opconServices, changedOperators, nil
+	}
+
+	// Skip the no-op update, it would only bump the resourceVersion and
+	// re-trigger the watchers of the OperandConfig
+	if servicesEqual(existingOpconServices.([]interface{}), opconServices) {
+		logger.V(2).Info("The OperandConfig is up to date, skipping the update")
+		operandConfigUpdatesTotal.WithLabelValues(UpdateResultSkipped).Inc()
+		return isEqual, opconServices, changedOperators, nil
+	}
+
+	// Write the merged services into the shadow OperandConfig, the live one is updated after approval
+	if r.Bootstrap.CSData.ShadowMergeEnable {
+		if err := r.updateShadowOperandConfig(ctx, opcon, opconServices); err != nil {
+			return true, nil, nil, err
+		}
+		return isEqual, opconServices, changedOperators, nil
+	}
+
+	logOperandConfigDiff(logger, existingOpconServices.([]interface{}), opconServices)
+	if err := r.writeOperandConfig(ctx, opcon, existingOpconServices.([]interface{}), opconServices); err != nil {
+		logger.Error(err, "Failed to update the OperandConfig")
+		return true, nil, nil, err
+	}
+	operandConfigUpdatesTotal.WithLabelValues(UpdateResultUpdated).Inc()
+	// The CPU limits are only stripped once the live OperandConfig carries them
+	if r.Bootstrap.CSData.CPUStripEventEnable {
+		r.recordCPUStripEvents(opcon, existingOpconServices.([]interface{}), opconServices)
+	}
+	if err := r.verifyOperandConfig(ctx, opconKey, opconServices); err != nil {
+		return true, nil, nil, err
+	}
+	r.refreshOperandRequests(ctx, logger, changedOperators)
+
+	return isEqual, opconServices, changedOperators, nil
 }
 
+// isOpResourceExists checks if the resource sets the resources of the operand
+// in data.spec.resources
 func isOpResourceExists(opResource interface{}) bool {
-	if opResource.(map[string]interface{})["data"] == nil {
-		klog.Info("### DEBUG: data not exists")
-		return false
+	_, ok := getOpResourceResources(opResource)
+	return ok
+}
+
+// getOpResourceResources returns the resources of the operand in
+// data.spec.resources of the resource. It returns false when any level is
+// missing or is not an object, e.g. a "data" written as a string or a list.
+func getOpResourceResources(opResource interface{}) (map[string]interface{}, bool) {
+	opResourceMap, ok := opResource.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	data, ok := opResourceMap["data"].(map[string]interface{})
+	if !ok {
+		return nil, false
 	}
-	if opResource.(map[string]interface{})["data"].(map[string]interface{})["spec"] == nil {
-		klog.Info("### DEBUG: data not exists")
-		return false
+	spec, ok := data["spec"].(map[string]interface{})
+	if !ok {
+		return nil, false
 	}
-	if opResource.(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["resources"] == nil {
-		klog.Info("### DEBUG: resources not exists")
-		return false
+	resources, ok := spec["resources"].(map[string]interface{})
+	return resources, ok
+}
+
+// specsEqual reports whether the CR specs of the merged services are equal to
+// the existing ones. A service, spec or CR missing from the existing services,
+// e.g. added by the replace merge strategy, counts as changed.
+func specsEqual(existingServices, mergedServices []interface{}) bool {
+	for _, opService := range mergedServices {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		spec, ok := opServiceMap["spec"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := opServiceMap["name"].(string)
+		existingService, ok := getItemByName(existingServices, name).(map[string]interface{})
+		if !ok {
+			return false
+		}
+		existingSpec, ok := existingService["spec"].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for cr, crSpec := range spec {
+			existingCRSpec, ok := existingSpec[cr].(map[string]interface{})
+			if !ok {
+				return false
+			}
+			if !rules.ResourceEqualComparison(existingCRSpec, crSpec) {
+				return false
+			}
+		}
 	}
 	return true
 }
 
+// getOperandConfigServices returns the services of the OperandConfig, the
+// missing spec or services are treated as no service. They are missing while
+// the OperandConfig is bootstrapped by ODLM.
+func getOperandConfigServices(opcon *unstructured.Unstructured) ([]interface{}, error) {
+	spec, ok := opcon.Object["spec"]
+	if !ok || spec == nil {
+		return []interface{}{}, nil
+	}
+	specMap, ok := spec.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the spec of OperandConfig %s/%s is not an object", opcon.GetNamespace(), opcon.GetName())
+	}
+	services, ok := specMap["services"]
+	if !ok || services == nil {
+		return []interface{}{}, nil
+	}
+	servicesSlice, ok := services.([]interface{})
+	if !ok {
+		servicesMap, ok := services.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the services of OperandConfig %s/%s are not a list or a map", opcon.GetNamespace(), opcon.GetName())
+		}
+		var err error
+		if servicesSlice, err = servicesMapToSlice(servicesMap); err != nil {
+			return nil, fmt.Errorf("the services of OperandConfig %s/%s are invalid: %w", opcon.GetNamespace(), opcon.GetName(), err)
+		}
+	}
+	if err := validateMergeDepth(servicesSlice, "the services of OperandConfig "+opcon.GetNamespace()+"/"+opcon.GetName()); err != nil {
+		return nil, err
+	}
+	return servicesSlice, nil
+}
+
+// sortServicesByName sorts the services by the operator name, so the
+// OperandConfig written doesn't churn between the reconciles. The keys of the
+// maps are sorted when the OperandConfig is encoded.
+func sortServicesByName(services []interface{}) {
+	name := func(service interface{}) string {
+		serviceMap, _ := service.(map[string]interface{})
+		name, _ := serviceMap["name"].(string)
+		return name
+	}
+	sort.SliceStable(services, func(i, j int) bool {
+		return name(services[i]) < name(services[j])
+	})
+}
+
+// setOperandConfigServices sets the services of the OperandConfig, in the
+// shape of its existing services
+func setOperandConfigServices(opcon *unstructured.Unstructured, services []interface{}) {
+	if opcon.Object["spec"] == nil {
+		opcon.Object["spec"] = map[string]interface{}{}
+	}
+	if isMapShapedServices(opcon) {
+		opcon.Object["spec"].(map[string]interface{})["services"] = servicesSliceToMap(services)
+		return
+	}
+	opcon.Object["spec"].(map[string]interface{})["services"] = services
+}
+
+// getOperandConfigKey returns the key of the OperandConfig the CommonService
+// CRs are merged into
+func (r *CommonServiceReconciler) getOperandConfigKey() (types.NamespacedName, error) {
+	if r.Bootstrap.CSData.OperandConfigName == "" {
+		return types.NamespacedName{}, fmt.Errorf("the name of the OperandConfig is empty")
+	}
+	return types.NamespacedName{
+		Name:      r.Bootstrap.CSData.OperandConfigName,
+		Namespace: r.Bootstrap.CSData.ServicesNs,
+	}, nil
+}
+
 func (r *CommonServiceReconciler) getExtremeizes(ctx context.Context, opconServices, ruleSlice []interface{}, extreme Extreme) ([]interface{}, error) {
-	// Fetch all the CommonService instances
-	csReq, err := labels.NewRequirement(constant.CsClonedFromLabel, selection.DoesNotExist, []string{})
+	return r.getExtremeizesWithout(ctx, opconServices, ruleSlice, extreme, nil, false)
+}
+
+// getExtremeizesWithout merges the active CommonService CRs like
+// getExtremeizes, leaving out the excluded CR. In dry run, the metrics, the
+// events and the conflicts kept for the status of the CRs are left untouched.
+func (r *CommonServiceReconciler) getExtremeizesWithout(ctx context.Context, opconServices, ruleSlice []interface{}, extreme Extreme, excluded *types.NamespacedName, dryRun bool) ([]interface{}, error) {
+	if !dryRun {
+		start := time.Now()
+		defer func() {
+			extremeizesDuration.WithLabelValues(string(extreme)).Observe(time.Since(start).Seconds())
+		}()
+	}
+
+	opconKey, err := r.getOperandConfigKey()
 	if err != nil {
 		return []interface{}{}, err
 	}
-	csObjectList := &apiv3.CommonServiceList{}
-	if err := r.Client.List(ctx, csObjectList, &client.ListOptions{
-		LabelSelector: labels.NewSelector().Add(*csReq),
-	}); err != nil {
+	logger := r.mergeLogger().WithValues("extreme", extreme)
+	activeCRs, err := r.listActiveCommonServices(ctx, logger)
+	if err != nil {
 		return []interface{}{}, err
 	}
-	csList, err := util.ObjectListToNewUnstructuredList(csObjectList)
+	if excluded != nil {
+		activeCRs = excludeCommonService(activeCRs, *excluded)
+	}
+	// With all the CRs terminating, e.g. in a mass tenant teardown, the
+	// summary is empty. The existing sizing is kept as the floor instead of
+	// shrinking the operands by nothing.
+	if len(activeCRs) == 0 {
+		logger.Info("Keeping the sizing of the OperandConfig, because no CommonService CR is active")
+		if !dryRun {
+			commonServiceCRsProcessed.Set(0)
+		}
+		return opconServices, nil
+	}
+
+	// The CRs failing to render are left out of the merge
+	activeCRs, csConfigsList, mappingList, err := r.getNewConfigsForCRs(logger, activeCRs)
 	if err != nil {
 		return []interface{}{}, err
 	}
-	var configSummary []interface{}
-	tmpConfigsSlice := make(map[int][]interface{})
+	if !dryRun {
+		commonServiceCRsProcessed.Set(float64(len(activeCRs)))
+	}
+	var deletedPaths [][]string
+	for i, cs := range activeCRs {
+		if !dryRun {
+			reportResourceEntries(logger, cs.GetNamespace()+"/"+cs.GetName(), csConfigsList[i])
+		}
+		// The keys set to the delete marker are left out of the summary
+		deletedPaths = append(deletedPaths, collectNullPaths(csConfigsList[i], false)...)
+	}
+
+	// Reduce the results in the order of the CRs
+	var masterConfigs []interface{}
 	serviceControllerMappingSummary := make(map[string]string)
-	for _, cs := range csList.Items {
-		if cs.GetDeletionTimestamp() != nil {
-			continue
+	for i, cs := range activeCRs {
+		if err := ctx.Err(); err != nil {
+			return []interface{}{}, err
+		}
+		if err := validateMergeDepth(csConfigsList[i], "the configs of CommonService "+cs.GetNamespace()+"/"+cs.GetName()); err != nil {
+			return []interface{}{}, err
+		}
+		if r.checkNamespace(cs.GetNamespace()+"/"+cs.GetName()) && csConfigsList[i] != nil {
+			// Keep a copy of master CR configs, the summary merging modifies them
+			masterConfigs = deepcopy.Copy(csConfigsList[i]).([]interface{})
+		}
+		serviceControllerMappingSummary = mergeProfileController(serviceControllerMappingSummary, mappingList[i])
+	}
+	if !dryRun {
+		r.reportProfileControllerConflicts(activeCRs, mappingList)
+		logProfileControllerMappingChange(logger, serviceControllerMappingSummary)
+	}
+
+	opconServices, requestedConfigsList, err := r.mergeActiveConfigs(ctx, logger, opconServices, activeCRs, csConfigsList, masterConfigs, serviceControllerMappingSummary, deletedPaths, ruleSlice, extreme, opconKey.Namespace)
+	if err != nil {
+		return []interface{}{}, err
+	}
+
+	if requestedConfigsList != nil && !dryRun {
+		r.recordSizingOverrides(activeCRs, requestedConfigsList, opconServices)
+	}
+
+	return opconServices, nil
+}
+
+// mergeActiveConfigs merges the configs rendered from the active CommonService
+// CRs into the OperandConfig services by the extreme size. The isolated, pinned
+// and aggregation overriding operators are split out of the summary of the
+// CRs, then the master configs, the deleted keys, the alignment of the limits
+// and the replica clamps are applied on the merged services. It also returns
+// the configs requested by the CRs, when they are kept to find the sizing
+// overrides.
+func (r *CommonServiceReconciler) mergeActiveConfigs(ctx context.Context, logger logr.Logger, opconServices []interface{}, activeCRs []unstructured.Unstructured, csConfigsList [][]interface{}, masterConfigs []interface{}, serviceControllerMappingSummary map[string]string, deletedPaths [][]string, ruleSlice []interface{}, extreme Extreme, opconNs string) ([]interface{}, [][]interface{}, error) {
+	// The isolated operators are left out of the summary of the CRs, they are
+	// sized by the master CR only
+	var isolatedMasterConfigs []interface{}
+	if isolated := getIsolatedOperators(ruleSlice); len(isolated) > 0 {
+		if masterConfigs != nil {
+			isolatedMasterConfigs, _ = splitIsolatedOperators(deepcopy.Copy(masterConfigs).([]interface{}), isolated)
+		}
+		for i := range csConfigsList {
+			_, csConfigsList[i] = splitIsolatedOperators(csConfigsList[i], isolated)
+		}
+	}
+
+	// The pinned operators are left out of the summary of the CRs too, they
+	// are expanded from their size profile
+	pinned, pinnedOverrides := r.getPinnedProfiles(activeCRs)
+	pinnedConfigs, err := expandPinnedProfiles(logger, pinned, pinnedOverrides)
+	if err != nil {
+		return []interface{}{}, nil, err
+	}
+	if len(pinned) > 0 {
+		pinnedOperators := map[string]bool{}
+		for operator := range pinned {
+			pinnedOperators[operator] = true
 		}
+		for i := range csConfigsList {
+			_, csConfigsList[i] = splitIsolatedOperators(csConfigsList[i], pinnedOperators)
+		}
+	}
+
+	// The operators overriding the aggregation are left out of the summary of
+	// the CRs too, they are summarized by their own extreme
+	overrideConfigsList := map[Extreme][][]interface{}{}
+	for group, operators := range groupAggregationOverrides(getAggregationOverrides(logger, ruleSlice), extreme) {
+		for i := range csConfigsList {
+			var overrideConfigs []interface{}
+			overrideConfigs, csConfigsList[i] = splitIsolatedOperators(csConfigsList[i], operators)
+			overrideConfigsList[group] = append(overrideConfigsList[group], overrideConfigs)
+		}
+	}
+
+	// Keep a copy of the requested configs, the summary merging modifies them
+	var requestedConfigsList [][]interface{}
+	if extreme == Max && len(activeCRs) > 1 {
+		requestedConfigsList = deepcopy.Copy(csConfigsList).([][]interface{})
+	}
 
-		csConfigs, serviceControllerMapping, err := r.getNewConfigs(&cs)
+	var provenance *mergeProvenance
+	if r.Bootstrap.CSData.MergeProvenanceEnable {
+		sources := make([]string, len(activeCRs))
+		for i, cs := range activeCRs {
+			sources[i] = cs.GetNamespace() + "/" + cs.GetName()
+		}
+		provenance = newMergeProvenance(sources)
+	}
+	opconServices, err = extremeizeServices(ctx, logger, provenance, opconServices, csConfigsList, ruleSlice, serviceControllerMappingSummary, extreme, opconNs, r.Bootstrap.CSData.MergeWorkers)
+	if err != nil {
+		return []interface{}{}, nil, err
+	}
+	if provenance != nil {
+		logProvenance(logger, provenance.provenance)
+	}
+	for _, group := range []Extreme{Max, Smallest} {
+		if overrideConfigsList[group] == nil {
+			continue
+		}
+		opconServices, err = extremeizeServices(ctx, logger.WithValues("aggregation", group), nil, opconServices, overrideConfigsList[group], ruleSlice, serviceControllerMappingSummary, group, opconNs, r.Bootstrap.CSData.MergeWorkers)
 		if err != nil {
-			return []interface{}{}, err
+			return []interface{}{}, nil, err
 		}
+	}
 
-		serviceControllerMappingSummary = mergeProfileController(serviceControllerMappingSummary, serviceControllerMapping)
-		tmpConfigsSlice[len(tmpConfigsSlice)] = csConfigs
+	// The master CR always wins the conflicts for the keys it sets
+	if r.Bootstrap.CSData.MasterWinsEnable && masterConfigs != nil {
+		opconServices = applyMasterConfigs(logger, opconServices, masterConfigs, ruleSlice, serviceControllerMappingSummary, opconNs)
 	}
-	for _, csConfigs := range tmpConfigsSlice {
-		configSummary = mergeCSCRs(configSummary, csConfigs, ruleSlice, serviceControllerMappingSummary, r.CSData.ServicesNs)
+
+	if isolatedMasterConfigs != nil {
+		opconServices = applyMasterConfigs(logger, opconServices, isolatedMasterConfigs, ruleSlice, serviceControllerMappingSummary, opconNs)
 	}
 
-	for _, opService := range opconServices {
-		crSummary := getItemByName(configSummary, opService.(map[string]interface{})["name"].(string))
+	if pinnedConfigs != nil {
+		opconServices = applyMasterConfigs(logger, opconServices, pinnedConfigs, ruleSlice, serviceControllerMappingSummary, opconNs)
+	}
+
+	// The keys deleted by any CR are deleted from the merged sizing
+	opconServices = deleteNullPaths(opconServices, deletedPaths)
+
+	// The larger requests are kept by the merge, the limits follow them
+	if extreme != Min {
+		opconServices = alignRequestsWithLimits(logger, opconServices)
+	}
 
-		rules := getItemByName(ruleSlice, opService.(map[string]interface{})["name"].(string))
+	opconServices = clampReplicas(logger, opconServices, ruleSlice)
+
+	return opconServices, requestedConfigsList, nil
+}
+
+// listActiveCommonServices lists the CommonService CRs contributing to the
+// sizing, leaving out the cloned unless they are included, the terminating
+// and, when filtered by namespace, the unwatched ones
+func (r *CommonServiceReconciler) listActiveCommonServices(ctx context.Context, logger logr.Logger) ([]unstructured.Unstructured, error) {
+	listOptions := &client.ListOptions{}
+	// The cloned CRs are excluded unless they represent real sizing demand,
+	// e.g. the CRs mirrored from another cluster
+	if !r.Bootstrap.CSData.IncludeClonedCRs {
+		csReq, err := labels.NewRequirement(constant.CsClonedFromLabel, selection.DoesNotExist, []string{})
+		if err != nil {
+			return nil, err
+		}
+		listOptions.LabelSelector = labels.NewSelector().Add(*csReq)
+	}
+	csObjectList, err := r.listCommonServices(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	csItems, err := commonServiceListToUnstructured(csObjectList)
+	if err != nil {
+		return nil, err
+	}
+	var activeCRs []unstructured.Unstructured
+	for _, cs := range csItems {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if cs.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if r.Bootstrap.CSData.FilterByNamespace && !r.isNamespaceInScope(cs.GetNamespace()) {
+			logger.V(2).Info("Skipping the CommonService, because its namespace is not watched", "cr", cs.GetName(), "namespace", cs.GetNamespace())
+			continue
+		}
+		activeCRs = append(activeCRs, cs)
+	}
+	return activeCRs, nil
+}
+
+// MergeConfigs merges the configs rendered from the CommonService CRs into the
+// OperandConfig services by the extreme size. It doesn't access the cluster,
+// so the rules can be tested and the merges previewed offline. The resources
+// without a namespace are placed in the OperandConfig namespace opconNs. It
+// stops with the context error once the context is cancelled.
+func MergeConfigs(ctx context.Context, opconServices []interface{}, csConfigsList [][]interface{}, ruleSlice []interface{}, serviceControllerMapping map[string]string, extreme Extreme, opconNs string) ([]interface{}, error) {
+	return extremeizeServices(ctx, defaultMergeLogger().WithValues("extreme", extreme), nil, opconServices, csConfigsList, ruleSlice, serviceControllerMapping, extreme, opconNs, 1)
+}
+
+// extremeizeServices summarizes the configs of all the CommonService CRs and
+// merges the summary into the OperandConfig services by the extreme size, the
+// operators are merged concurrently by the workers. It stops with the context
+// error once the context is cancelled.
+func extremeizeServices(ctx context.Context, logger logr.Logger, provenance *mergeProvenance, opconServices []interface{}, csConfigsList [][]interface{}, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, extreme Extreme, opconNs string, workers int) ([]interface{}, error) {
+	var configSummary []interface{}
+	if extreme == Avg {
+		// Averaging can't be done pairwise, all the CRs are aggregated at once
+		configSummary = averageCSConfigs(logger, csConfigsList, ruleSlice, serviceControllerMappingSummary, opconNs)
+	} else if extreme == Sum {
+		configSummary = sumCSConfigs(logger, csConfigsList, ruleSlice, serviceControllerMappingSummary, opconNs)
+	} else if extreme == Smallest {
+		configSummary = smallestCSConfigs(logger, csConfigsList, ruleSlice, serviceControllerMappingSummary, opconNs)
+	} else {
+		for i, csConfigs := range csConfigsList {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			configSummary = mergeCSCRs(logger, configSummary, csConfigs, ruleSlice, serviceControllerMappingSummary, opconNs, provenance.forConfigs(i))
+		}
+	}
+
+	// The summary is only read while the operators are merged
+	err := forEachOperator(ctx, workers, len(opconServices), func(i int) {
+		opService, ok := opconServices[i].(map[string]interface{})
+		if !ok {
+			logger.Info("Skipping merging the operator, because it is not an object", "operator", opconServices[i])
+			return
+		}
+		operatorName, ok := opService["name"].(string)
+		if !ok || operatorName == "" {
+			logger.Info("Skipping merging the operator, because its name is not a string", "operator", opService["name"])
+			return
+		}
+		crSummary, _ := getItemByIdentity(configSummary, opService).(map[string]interface{})
+
+		rules := getItemByIdentity(ruleSlice, opService)
+		existingService := snapshotForMergeLog(opService, rules)
 		serviceController := serviceControllerMappingSummary["profileController"]
-		if controller, ok := serviceControllerMappingSummary[opService.(map[string]interface{})["name"].(string)]; ok {
+		if controller, ok := serviceControllerMappingSummary[operatorName]; ok {
 			serviceController = controller
 		}
 
-		if opService.(map[string]interface{})["spec"] != nil {
-			for cr, spec := range opService.(map[string]interface{})["spec"].(map[string]interface{}) {
-				if _, ok := nonDefaultProfileController[serviceController]; ok {
+		if opService["spec"] != nil {
+			opSpec, ok := opService["spec"].(map[string]interface{})
+			if !ok {
+				logger.Info("Skipping merging the spec, because it is not an object", "operator", operatorName)
+				return
+			}
+			summarySpec, _ := crSummary["spec"].(map[string]interface{})
+			for cr, spec := range opSpec {
+				specMap, ok := spec.(map[string]interface{})
+				if !ok {
+					logger.Info("Skipping merging the CR, because it is not an object in the OperandConfig", "operator", operatorName, "cr", cr)
+					continue
+				}
+				if isNonDefaultProfileController(serviceController) {
 					// clean up OperandConfig
-					opService.(map[string]interface{})["spec"].(map[string]interface{})[cr] = resetResourceInTemplate(spec.(map[string]interface{}), cr, rules)
+					specMap = resetResourceInTemplate(logger, specMap, cr, rules, serviceController)
+					opSpec[cr] = specMap
 				}
-				if crSummary == nil || crSummary.(map[string]interface{})["spec"] == nil || crSummary.(map[string]interface{})["spec"].(map[string]interface{})[cr] == nil {
+				if summarySpec[cr] == nil {
 					continue
 				}
-				serviceForCR := crSummary.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
-				opService.(map[string]interface{})["spec"].(map[string]interface{})[cr] = shrinkSize(spec.(map[string]interface{}), serviceForCR, extreme)
+				serviceForCR, ok := summarySpec[cr].(map[string]interface{})
+				if !ok {
+					logger.Info("Skipping merging the CR, because it is not an object in the CommonService", "operator", operatorName, "cr", cr)
+					continue
+				}
+				opSpec[cr] = shrinkSize(logger, specMap, serviceForCR, getRuleForCR(rules, cr), extreme)
 			}
 		}
 
-		if opService.(map[string]interface{})["resources"] != nil {
-			if opResources, ok := opService.(map[string]interface{})["resources"].([]interface{}); ok {
+		if opService["resources"] != nil {
+			if opResources, ok := opService["resources"].([]interface{}); ok {
 				for i, opResource := range opResources {
 					// get resource by checking apiVersion, kind, name, namespace
-					var apiVersion, kind, name, namespace string
-					if opResource.(map[string]interface{})["apiVersion"] != nil {
-						apiVersion = opResource.(map[string]interface{})["apiVersion"].(string)
-					}
-					if opResource.(map[string]interface{})["kind"] != nil {
-						kind = opResource.(map[string]interface{})["kind"].(string)
-					}
-					if opResource.(map[string]interface{})["name"] != nil {
-						name = opResource.(map[string]interface{})["name"].(string)
-					}
-					if opResource.(map[string]interface{})["namespace"] != nil {
-						namespace = opResource.(map[string]interface{})["namespace"].(string)
+					opResourceMap, ok := opResource.(map[string]interface{})
+					if !ok {
+						logger.Info("Skipping merging the resource, because it is not an object", "operator", operatorName, "resource", opResource)
+						continue
 					}
+					apiVersion, _ := opResourceMap["apiVersion"].(string)
+					kind, _ := opResourceMap["kind"].(string)
+					name, _ := opResourceMap["name"].(string)
+					namespace, _ := opResourceMap["namespace"].(string)
 					// check if above 4 fields are all set
 					if apiVersion == "" || kind == "" || name == "" {
-						klog.Warningf("Skipping merging resource %s/%s/%s/%s, because apiVersion, kind or name is not set", apiVersion, kind, name, namespace)
+						logger.Info("Skipping merging the resource, because apiVersion, kind or name is not set", "operator", operatorName, "apiVersion", apiVersion, "kind", kind, "name", name, "namespace", namespace)
 						continue
 					}
 					// check if namespace is set, if not, set it to OperandConfig namespace
 					if namespace == "" {
-						namespace = r.CSData.ServicesNs
+						namespace = opconNs
 					}
 
-					if crSummary == nil || crSummary.(map[string]interface{})["resources"] == nil {
+					summarizedResources, ok := crSummary["resources"].([]interface{})
+					if !ok {
 						continue
 					}
 
-					summarizedRes := getItemByGVKNameNamespace(crSummary.(map[string]interface{})["resources"].([]interface{}), r.CSData.ServicesNs, apiVersion, kind, name, namespace)
-					if summarizedRes != nil {
-						if _, ok := nonDefaultProfileController[serviceController]; ok {
-							if isOpResourceExists(summarizedRes) {
-								klog.Info("### DEBUG: deleting key")
-								summarizedRes.(map[string]interface{})["data"].(map[string]interface{})["spec"].(map[string]interface{})["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"] = struct{}{}
-							}
+					summarizedRes, ok := getItemByGVKNameNamespace(summarizedResources, opconNs, apiVersion, kind, name, namespace).(map[string]interface{})
+					if ok {
+						if extreme == Min {
+							ruleRes, _ := getRuleForResource(rules, apiVersion, kind, name).(map[string]interface{})
+							opResources[i] = shrinkSizeWithRules(opResourceMap, summarizedRes, ruleRes)
+						} else {
+							opResources[i] = shrinkSize(logger, opResourceMap, summarizedRes, nil, extreme)
 						}
-						opResources[i] = shrinkSize(opResource.(map[string]interface{}), summarizedRes.(map[string]interface{}), extreme)
+						stripCPULimit(logger, opResources[i], operatorName, serviceController)
 					}
 				}
-				opService.(map[string]interface{})["resources"] = opResources
+				opService["resources"] = opResources
 			}
 		}
+		logMergeDecisions("extreme size "+string(extreme), existingService, opService, rules)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return opconServices, nil
 }
 
-func (r *CommonServiceReconciler) handleDelete(ctx context.Context) error {
-	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
-	opconKey := types.NamespacedName{
-		Name:      "common-service",
-		Namespace: r.Bootstrap.CSData.ServicesNs,
+// handleDelete shrinks the OperandConfig after a CommonService CR is deleted.
+// When the deleted instance is known, only the operators it configured are
+// recomputed, otherwise all the operators are. The shrinking is retried on the
+// OperandConfig fetched again when the update conflicts with another writer,
+// and it is serialized with the merges of the operator itself.
+func (r *CommonServiceReconciler) handleDelete(ctx context.Context, instance *apiv3.CommonService) error {
+	opconKey, err := r.getOperandConfigKey()
+	if err != nil {
+		return err
+	}
+	defer lockOperandConfig(opconKey)()
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return r.handleDeleteOnce(ctx, instance)
+	})
+}
+
+func (r *CommonServiceReconciler) handleDeleteOnce(ctx context.Context, instance *apiv3.CommonService) error {
+	opconKey, err := r.getOperandConfigKey()
+	if err != nil {
+		return err
 	}
+	logger := r.mergeLogger().WithValues("operandConfig", opconKey.String())
+	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
 	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
-		klog.Errorf("failed to get OperandConfig %s: %v", opconKey.String(), err)
+		if err := wrapOperandConfigNotFound(opconKey, err); errors.Is(err, ErrOperandConfigNotFound) {
+			logger.Info("Skipping the deletion, because the OperandConfig is not found yet")
+			return err
+		}
+		logger.Error(err, "Failed to get the OperandConfig")
 		return err
 	}
 
-	opconServices := opcon.Object["spec"].(map[string]interface{})["services"].([]interface{})
-
-	// Convert rules string to slice
-	ruleSlice, err := convertStringToSlice(rules.ConfigurationRules)
+	opconServices, err := getOperandConfigServices(opcon)
 	if err != nil {
 		return err
 	}
-	opconServices, err = r.getExtremeizes(ctx, opconServices, ruleSlice, Min)
+	existingOpconServices := deepcopy.Copy(opconServices)
+	opconServices, err = r.shrinkOperandConfigServices(ctx, logger, opconKey, opconServices, instance, nil)
 	if err != nil {
 		return err
 	}
-
-	opcon.Object["spec"].(map[string]interface{})["services"] = opconServices
-
-	if err := r.Update(ctx, opcon); err != nil {
-		klog.Errorf("failed to update OperandConfig %s: %v", opconKey.String(), err)
+	if opconServices == nil {
+		return nil
+	}
+	if servicesEqual(existingOpconServices.([]interface{}), opconServices) {
+		logger.V(2).Info("The OperandConfig is up to date, skipping the update")
+		operandConfigUpdatesTotal.WithLabelValues(UpdateResultSkipped).Inc()
+		return nil
+	}
+	if isOperandConfigFrozen(opcon) {
+		logger.Info("The OperandConfig is frozen, skipping the update", "annotation", FreezeAnnoKey, "changedOperators", getChangedOperators(existingOpconServices.([]interface{}), opconServices))
+		operandConfigUpdatesTotal.WithLabelValues(UpdateResultSkipped).Inc()
+		return nil
+	}
+	// The shrunk services go through the shadow OperandConfig as the merged ones do
+	if r.Bootstrap.CSData.ShadowMergeEnable {
+		return r.updateShadowOperandConfig(ctx, opcon, opconServices)
+	}
+	if err := r.writeOperandConfig(ctx, opcon, existingOpconServices.([]interface{}), opconServices); err != nil {
+		logger.Error(err, "Failed to update the OperandConfig")
+		return err
+	}
+	operandConfigUpdatesTotal.WithLabelValues(UpdateResultUpdated).Inc()
+	if err := r.verifyOperandConfig(ctx, opconKey, opconServices); err != nil {
 		return err
 	}
+	r.refreshOperandRequests(ctx, logger, getChangedOperators(existingOpconServices.([]interface{}), opconServices))
 
 	return nil
 }
 
+// shrinkOperandConfigServices returns the OperandConfig services shrunk after
+// the deletion of the instance, or nil when the shrinking is skipped. The
+// services are shrunk in place. The excluded CR is left out of the merge as if
+// it were already gone.
+func (r *CommonServiceReconciler) shrinkOperandConfigServices(ctx context.Context, logger logr.Logger, opconKey types.NamespacedName, opconServices []interface{}, instance *apiv3.CommonService, excluded *types.NamespacedName) ([]interface{}, error) {
+	// Convert rules string to slice
+	ruleSlice, err := getConfigurationRules()
+	if err != nil {
+		return nil, err
+	}
+	existingOpconServices := deepcopy.Copy(opconServices)
+	if instance != nil {
+		deletedConfigs, serviceControllerMapping, err := r.getDeletedConfigs(instance, ruleSlice)
+		if err != nil {
+			return nil, err
+		}
+		// The deletion can't shrink the sizing dominated by the other CRs, but
+		// it can raise the smallest request of the min aggregated operators
+		if !hasMinAggregation(deletedConfigs, ruleSlice) && isDeletedConfigsDominated(deletedConfigs, serviceControllerMapping, opconServices, opconKey.Namespace) {
+			logger.Info("Skipping shrinking the OperandConfig, the sizing of the deleted CommonService is dominated by the other CommonService CRs", "cr", instance.Name, "namespace", instance.Namespace)
+			return nil, nil
+		}
+		operators := serviceNames(deletedConfigs)
+		logger.Info("Shrinking the operators configured by the deleted CommonService", "operators", operators, "cr", instance.Name, "namespace", instance.Namespace)
+		scopedServices := scopeServices(opconServices, operators)
+		if len(scopedServices) == 0 {
+			return nil, nil
+		}
+		// The scoped services are shrunk in place in the OperandConfig services
+		if _, err := r.getExtremeizesWithout(ctx, scopedServices, ruleSlice, Min, excluded, false); err != nil {
+			return nil, err
+		}
+	} else {
+		opconServices, err = r.getExtremeizesWithout(ctx, opconServices, ruleSlice, Min, excluded, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Keep the shrunk replicas from violating the minAvailable of the operands
+	if r.Bootstrap.CSData.PDBCheckEnable {
+		opconServices = r.clampShrinkToMinAvailable(ctx, existingOpconServices.([]interface{}), opconServices, ruleSlice)
+	}
+
+	// The overlay of the platform wins over the sizing of all the CRs
+	opconServices, err = r.overlaySizing(ctx, logger, opconServices)
+	if err != nil {
+		return nil, err
+	}
+	opconServices = r.transformServices(logger, opconServices)
+	sortServicesByName(opconServices)
+	return opconServices, nil
+}
+
+// copyConfigs returns a deep copy of the configs
+func copyConfigs(configs []interface{}) []interface{} {
+	if configs == nil {
+		return nil
+	}
+	return deepcopy.Copy(configs).([]interface{})
+}
+
+var (
+	// ErrRulesYAML is returned by convertStringToSlice when the string is not
+	// valid YAML, the YAML error carries the line
+	ErrRulesYAML = errors.New("failed to convert yaml to json")
+	// ErrRulesUnmarshal is returned by convertStringToSlice when the YAML is
+	// valid but is not a list
+	ErrRulesUnmarshal = errors.New("failed to convert string to slice")
+)
+
 func convertStringToSlice(str string) ([]interface{}, error) {
 
 	jsonSpec, err := utilyaml.YAMLToJSON([]byte(str))
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert yaml to json: %v", err)
+		return nil, fmt.Errorf("%w: %w", ErrRulesYAML, err)
 	}
 
 	// Create a slice
@@ -681,7 +1674,7 @@ func convertStringToSlice(str string) ([]interface{}, error) {
 	// Convert sizes string to slice
 	err = json.Unmarshal(jsonSpec, &slice)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert string to slice: %v", err)
+		return nil, fmt.Errorf("%w: %w", ErrRulesUnmarshal, err)
 	}
 
 	return slice, nil
@@ -689,20 +1682,80 @@ func convertStringToSlice(str string) ([]interface{}, error) {
 
 func getItemByName(slice []interface{}, name string) interface{} {
 	for _, item := range slice {
-		if item.(map[string]interface{})["name"].(string) == name {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if itemName, ok := itemMap["name"].(string); ok && itemName == name {
 			return item
 		}
 	}
 	return nil
 }
 
-func setSpecByName(slice []interface{}, name string, spec interface{}) []interface{} {
+// getItemByNormalizedName returns the item with the same name ignoring the
+// case and the surrounding whitespace, the exact match is preferred
+func getItemByNormalizedName(slice []interface{}, name string) interface{} {
+	if item := getItemByName(slice, name); item != nil {
+		return item
+	}
+	name = normalizeName(name)
 	for _, item := range slice {
-		if item.(map[string]interface{})["name"].(string) == name {
-			item.(map[string]interface{})["spec"] = spec
-			return slice
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if itemName, ok := itemMap["name"].(string); ok && normalizeName(itemName) == name {
+			return item
+		}
+	}
+	return nil
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// getItemByIdentity returns the item with the same name as the given one, or
+// with the same stable identity when the operator has been renamed. The names
+// and identities are compared ignoring the case and the surrounding whitespace.
+func getItemByIdentity(slice []interface{}, item interface{}) interface{} {
+	identity := normalizeName(getIdentity(item))
+	if identity == "" {
+		return nil
+	}
+	if name, ok := item.(map[string]interface{})["name"].(string); ok {
+		if found := getItemByNormalizedName(slice, name); found != nil {
+			return found
+		}
+	}
+	for _, candidate := range slice {
+		if normalizeName(getIdentity(candidate)) == identity {
+			return candidate
 		}
 	}
+	return nil
+}
+
+// getIdentity returns the stable identity of the operator, which defaults to
+// the name
+func getIdentity(item interface{}) string {
+	itemMap, ok := item.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if identity, ok := itemMap[OperatorIdentityKey].(string); ok && identity != "" {
+		return identity
+	}
+	name, _ := itemMap["name"].(string)
+	return name
+}
+
+func setSpecByName(slice []interface{}, name string, spec interface{}) []interface{} {
+	if item := getItemByName(slice, name); item != nil {
+		item.(map[string]interface{})["spec"] = spec
+		return slice
+	}
 	newItem := map[string]interface{}{
 		"name": name,
 		"spec": spec,
@@ -711,11 +1764,9 @@ func setSpecByName(slice []interface{}, name string, spec interface{}) []interfa
 }
 
 func setResByName(slice []interface{}, name string, resources []interface{}) []interface{} {
-	for _, item := range slice {
-		if item.(map[string]interface{})["name"].(string) == name {
-			item.(map[string]interface{})["resources"] = resources
-			return slice
-		}
+	if item := getItemByName(slice, name); item != nil {
+		item.(map[string]interface{})["resources"] = resources
+		return slice
 	}
 	newItem := map[string]interface{}{
 		"name":      name,
@@ -729,24 +1780,44 @@ func (r *CommonServiceReconciler) checkNamespace(key string) bool {
 	return key == r.Bootstrap.CSData.OperatorNs+"/common-service"
 }
 
+// isNamespaceInScope tells whether the namespace is watched by this operator
+// instance, all the namespaces are watched when no namespace is listed
+func (r *CommonServiceReconciler) isNamespaceInScope(namespace string) bool {
+	if r.Bootstrap.CSData.WatchNamespaces == "" {
+		return true
+	}
+	if namespace == r.Bootstrap.CSData.OperatorNs || namespace == r.Bootstrap.CSData.ServicesNs {
+		return true
+	}
+	return util.Contains(strings.Split(r.Bootstrap.CSData.WatchNamespaces, ","), namespace)
+}
+
 // updatePhase sets the current Phase status.
 func (r *CommonServiceReconciler) updatePhase(ctx context.Context, instance *apiv3.CommonService, status string) error {
 	instance.Status.Phase = status
 	return r.Client.Status().Update(ctx, instance)
 }
 
-func resetResourceInTemplate(changedMap map[string]interface{}, cr string, rules interface{}) map[string]interface{} {
-	var rulesForCR map[string]interface{}
-	if rules != nil && rules.(map[string]interface{})["spec"] != nil && rules.(map[string]interface{})["spec"].(map[string]interface{})[cr] != nil {
-		rulesForCR = rules.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
+// resetResourceInTemplate cleans up the sizing keys of a CR managed by a
+// non-default profile controller, the profile is also cleaned up when the
+// controller is registered to reset it
+func resetResourceInTemplate(logger logr.Logger, changedMap map[string]interface{}, cr string, rules interface{}, serviceController string) map[string]interface{} {
+	rulesForCR := getRuleForCR(rules, cr)
+	if rulesForCR == nil {
+		// Nothing is reset without the rules of the CR
+		if ruleForCR := childRule(childRule(rules, "spec"), cr); ruleForCR != nil {
+			logger.Info("Skipping resetting the sizing of the CR, because its rules are not an object", "cr", cr)
+		}
+		return changedMap
 	}
+	resetKeys := getProfileControllerResetKeys(serviceController)
 	for key := range changedMap {
-		resetChangedMap(key, changedMap[key], rulesForCR, changedMap)
+		resetChangedMap(key, changedMap[key], rulesForCR, changedMap, resetKeys)
 	}
 	return changedMap
 }
 
-func resetChangedMap(key string, changedMap interface{}, rulesForCR, finalMap map[string]interface{}) {
+func resetChangedMap(key string, changedMap interface{}, rulesForCR, finalMap map[string]interface{}, resetKeys map[string]bool) {
 	var rules interface{}
 	if rulesForCR != nil {
 		rules = rulesForCR[key]
@@ -758,32 +1829,72 @@ func resetChangedMap(key string, changedMap interface{}, rulesForCR, finalMap ma
 				rulesRef := rules.(map[string]interface{})
 				changedMapRef := changedMap
 				for newKey := range changedMapRef {
-					resetChangedMap(newKey, changedMapRef[newKey], rulesRef, finalMap[key].(map[string]interface{}))
+					resetChangedMap(newKey, changedMapRef[newKey], rulesRef, finalMap[key].(map[string]interface{}), resetKeys)
 				}
+			} else if len(changedMap) == 0 && resetKeys[key] {
+				// The empty marker left for a reset value, e.g. cpu: {}
+				delete(finalMap, key)
 			}
 
 		default:
-			var requiredResetKeys = map[string]bool{
-				"replicas": true,
-				"cpu":      true,
-				"memory":   true,
-				// "profile":  true,
-			}
-			if _, ok := requiredResetKeys[key]; ok {
+			if resetKeys[key] {
 				delete(finalMap, key)
 			}
 		}
 	}
 }
 
+// getRuleForResource returns the rule of the resource from the rules of the
+// operator, the rules don't carry the namespace of the resources
+func getRuleForResource(rules interface{}, apiVersion, kind, name string) interface{} {
+	rulesMap, ok := rules.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	ruleResources, ok := rulesMap["resources"].([]interface{})
+	if !ok {
+		return nil
+	}
+	for _, ruleResource := range ruleResources {
+		ruleResourceMap, ok := ruleResource.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ruleResourceMap["apiVersion"] == apiVersion && ruleResourceMap["kind"] == kind && ruleResourceMap["name"] == name {
+			return ruleResource
+		}
+	}
+	return nil
+}
+
+// WildcardNamespace is the namespace of a resource in the CommonService CR
+// matching the resources of the same apiVersion, kind and name in all the
+// namespaces, e.g. the resources living in the per-tenant namespaces
+const WildcardNamespace = "*"
+
+// getItemByGVKNameNamespace returns the resource matching the apiVersion, kind,
+// name and namespace. A resource in the namespace itself wins over a resource
+// in the WildcardNamespace, and the latter is returned as a copy placed in the
+// namespace, so each matching resource is merged independently.
 func getItemByGVKNameNamespace(opResources []interface{}, opconNs, apiVersion, kind, name, namespace string) interface{} {
+	var wildcardResource map[string]interface{}
 	for _, opResource := range opResources {
-		if opResource.(map[string]interface{})["apiVersion"].(string) == apiVersion &&
-			opResource.(map[string]interface{})["kind"].(string) == kind &&
-			opResource.(map[string]interface{})["name"].(string) == name {
-			if opResNs, ok := opResource.(map[string]interface{})["namespace"]; ok {
-				if opResNs.(string) == namespace {
+		opResourceMap, ok := opResource.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		resApiVersion, _ := opResourceMap["apiVersion"].(string)
+		resKind, _ := opResourceMap["kind"].(string)
+		resName, _ := opResourceMap["name"].(string)
+		if resApiVersion == "" || resKind == "" || resName == "" {
+			continue
+		}
+		if resApiVersion == apiVersion && resKind == kind && resName == name {
+			if opResNs, ok := opResourceMap["namespace"]; ok {
+				if opResNs, ok := opResNs.(string); ok && opResNs == namespace {
 					return opResource
+				} else if ok && opResNs == WildcardNamespace && wildcardResource == nil {
+					wildcardResource = opResourceMap
 				}
 			} else {
 				if opconNs == namespace {
@@ -792,5 +1903,10 @@ func getItemByGVKNameNamespace(opResources []interface{}, opconNs, apiVersion, k
 			}
 		}
 	}
-	return nil
+	if wildcardResource == nil {
+		return nil
+	}
+	resource := deepcopy.Copy(wildcardResource).(map[string]interface{})
+	resource["namespace"] = namespace
+	return resource
 }
@@ -0,0 +1,213 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// OperandConfigDiffLogLevel is the verbosity of the OperandConfig diff logs
+	OperandConfigDiffLogLevel = 2
+
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+)
+
+// serviceChange is a leaf key changed between two OperandConfig services
+type serviceChange struct {
+	Op   string
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+func (c serviceChange) String() string {
+	switch c.Op {
+	case DiffAdded:
+		return fmt.Sprintf("%s %s: %s", c.Op, c.Path, formatDiffValue(c.New))
+	case DiffRemoved:
+		return fmt.Sprintf("%s %s: %s", c.Op, c.Path, formatDiffValue(c.Old))
+	}
+	return fmt.Sprintf("%s %s: %s -> %s", c.Op, c.Path, formatDiffValue(c.Old), formatDiffValue(c.New))
+}
+
+// serviceChangeStrings formats the changes, in the order they are listed
+func serviceChangeStrings(changes []serviceChange) []string {
+	var lines []string
+	for _, change := range changes {
+		lines = append(lines, change.String())
+	}
+	return lines
+}
+
+func formatDiffValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// logOperandConfigDiff logs the leaf keys changed in the OperandConfig services
+func logOperandConfigDiff(logger logr.Logger, existing, updated []interface{}) {
+	logger = logger.V(OperandConfigDiffLogLevel)
+	if !logger.Enabled() {
+		return
+	}
+	for _, change := range diffOperandConfigServices(existing, updated) {
+		logger.Info("Updating the OperandConfig", "change", change.String())
+	}
+}
+
+// diffOperandConfigServices walks the services by operator name and lists the
+// leaf keys added, removed and changed. The resources are matched by their kind
+// and name instead of their position.
+func diffOperandConfigServices(existing, updated []interface{}) []serviceChange {
+	var changes []serviceChange
+	for _, name := range serviceNames(existing, updated) {
+		changes = diffLeaves(name, getItemByName(existing, name), getItemByName(updated, name), changes)
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// getChangedOperators returns the names of the operators whose spec or
+// resources differ between the existing and the updated services
+func getChangedOperators(existing, updated []interface{}) []string {
+	var changed []string
+	for _, name := range serviceNames(existing, updated) {
+		existingService, _ := getItemByName(existing, name).(map[string]interface{})
+		updatedService, _ := getItemByName(updated, name).(map[string]interface{})
+		if !reflect.DeepEqual(existingService["spec"], updatedService["spec"]) || !reflect.DeepEqual(existingService["resources"], updatedService["resources"]) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// servicesEqual tells whether the services are the same as a whole, the
+// number types are normalized through JSON before comparing
+func servicesEqual(existing, updated []interface{}) bool {
+	normalizedExisting, err := normalizeServices(existing)
+	if err != nil {
+		return false
+	}
+	normalizedUpdated, err := normalizeServices(updated)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(normalizedExisting, normalizedUpdated)
+}
+
+func serviceNames(serviceLists ...[]interface{}) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, services := range serviceLists {
+		for _, service := range services {
+			serviceMap, ok := service.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, ok := serviceMap["name"].(string)
+			if !ok || seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func diffLeaves(path string, existing, updated interface{}, changes []serviceChange) []serviceChange {
+	if reflect.DeepEqual(existing, updated) {
+		return changes
+	}
+
+	existingMap, existingIsMap := existing.(map[string]interface{})
+	updatedMap, updatedIsMap := updated.(map[string]interface{})
+	if (existingIsMap || existing == nil) && (updatedIsMap || updated == nil) && (existingIsMap || updatedIsMap) {
+		for _, key := range unionKeys(existingMap, updatedMap) {
+			changes = diffLeaves(path+"."+key, existingMap[key], updatedMap[key], changes)
+		}
+		return changes
+	}
+
+	existingList, existingIsList := existing.([]interface{})
+	updatedList, updatedIsList := updated.([]interface{})
+	if (existingIsList || existing == nil) && (updatedIsList || updated == nil) && (existingIsList || updatedIsList) {
+		existingItems := listItemsByKey(existingList)
+		updatedItems := listItemsByKey(updatedList)
+		for _, key := range unionKeys(existingItems, updatedItems) {
+			changes = diffLeaves(path+"["+key+"]", existingItems[key], updatedItems[key], changes)
+		}
+		return changes
+	}
+
+	switch {
+	case existing == nil:
+		return append(changes, serviceChange{Op: DiffAdded, Path: path, New: updated})
+	case updated == nil:
+		return append(changes, serviceChange{Op: DiffRemoved, Path: path, Old: existing})
+	}
+	return append(changes, serviceChange{Op: DiffChanged, Path: path, Old: existing, New: updated})
+}
+
+// listItemsByKey keys the list items by "<kind>/<name>" when they have one,
+// and by their index otherwise
+func listItemsByKey(list []interface{}) map[string]interface{} {
+	items := make(map[string]interface{}, len(list))
+	for i, item := range list {
+		key := strconv.Itoa(i)
+		if itemMap, ok := item.(map[string]interface{}); ok {
+			kind, _ := itemMap["kind"].(string)
+			name, _ := itemMap["name"].(string)
+			if kind != "" && name != "" {
+				key = kind + "/" + name
+			} else if name != "" {
+				key = name
+			}
+		}
+		if _, ok := items[key]; ok {
+			key = strconv.Itoa(i)
+		}
+		items[key] = item
+	}
+	return items
+}
+
+func unionKeys(existing, updated map[string]interface{}) []string {
+	var keys []string
+	for key := range existing {
+		keys = append(keys, key)
+	}
+	for key := range updated {
+		if _, ok := existing[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,280 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("diffOperandConfigServices", func() {
+	existing := `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 100m
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        replicas: 1
+  - apiVersion: v1
+    kind: ConfigMap
+    name: test-configmap
+    data:
+      data:
+        size: small
+`
+
+	DescribeTable("should list the leaf keys changed in the services",
+		func(updated string, expected []string) {
+			var changes []string
+			for _, change := range diffOperandConfigServices(mustConvertStringToSlice(existing), mustConvertStringToSlice(updated)) {
+				changes = append(changes, change.String())
+			}
+			Expect(changes).To(Equal(expected))
+		},
+		Entry("changed cpu value", `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 200m
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        replicas: 1
+  - apiVersion: v1
+    kind: ConfigMap
+    name: test-configmap
+    data:
+      data:
+        size: small
+`, []string{
+			"changed ibm-test-operator.spec.testCR.resources.limits.cpu: 100m -> 200m",
+		}),
+		Entry("added CR spec", `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 100m
+    newCR:
+      replicas: 2
+      resources:
+        limits:
+          memory: 1Gi
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        replicas: 1
+  - apiVersion: v1
+    kind: ConfigMap
+    name: test-configmap
+    data:
+      data:
+        size: small
+`, []string{
+			"added ibm-test-operator.spec.newCR.replicas: 2",
+			"added ibm-test-operator.spec.newCR.resources.limits.memory: 1Gi",
+		}),
+		Entry("removed resource entry", `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 100m
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: test-configmap
+    data:
+      data:
+        size: small
+`, []string{
+			"removed ibm-test-operator.resources[Deployment/test-deployment].apiVersion: apps/v1",
+			"removed ibm-test-operator.resources[Deployment/test-deployment].data.spec.replicas: 1",
+			"removed ibm-test-operator.resources[Deployment/test-deployment].kind: Deployment",
+			"removed ibm-test-operator.resources[Deployment/test-deployment].name: test-deployment",
+		}),
+	)
+})
+
+var _ = Describe("updateOperandConfigWithChanges", func() {
+	var (
+		r          *CommonServiceReconciler
+		newConfigs = `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 200m
+- name: ibm-other-operator
+  spec:
+    otherCR:
+      resources:
+        limits:
+          cpu: 100m
+- name: ibm-third-operator
+  spec:
+    thirdCR:
+      resources:
+        limits:
+          cpu: 300m
+`
+		mapping = map[string]string{"profileController": "default"}
+	)
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 100m
+- name: ibm-other-operator
+  spec:
+    otherCR:
+      resources:
+        limits:
+          cpu: 100m
+- name: ibm-third-operator
+  spec:
+    thirdCR:
+      resources:
+        limits:
+          cpu: 100m
+`)))
+	})
+
+	It("should return the operators changed by the update", func() {
+		isEqual, changedOperators, err := r.updateOperandConfigWithChanges(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isEqual).To(BeFalse())
+		Expect(changedOperators).To(Equal([]string{"ibm-test-operator", "ibm-third-operator"}))
+	})
+
+	It("should return no operator when the same configs are applied again", func() {
+		_, _, err := r.updateOperandConfigWithChanges(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		isEqual, changedOperators, err := r.updateOperandConfigWithChanges(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isEqual).To(BeTrue())
+		Expect(changedOperators).To(BeEmpty())
+	})
+})
+
+var _ = Describe("updateOperandConfig no-op updates", func() {
+	var (
+		r       *CommonServiceReconciler
+		writes  *int
+		mapping = map[string]string{"profileController": "default"}
+	)
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+      labels:
+        team: a
+`)))
+		writes = countOperandConfigWrites(r)
+	})
+
+	It("should only write the OperandConfig on a change", func() {
+		By("updating the OperandConfig on a genuine change")
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*writes).To(Equal(1))
+
+		By("updating the OperandConfig on a non-sizing change")
+		_, err = r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      labels:
+        team: b
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*writes).To(Equal(2))
+
+		By("skipping the update when the same configs are applied again")
+		isEqual, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+      labels:
+        team: b
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isEqual).To(BeTrue())
+		Expect(*writes).To(Equal(2))
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-test-operator", "testCR")["replicas"]).To(BeEquivalentTo(2))
+	})
+})
+
+var _ = Describe("logOperandConfigDiff", func() {
+	It("should log the changes with the logger of the merge", func() {
+		r := newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)))
+		sink := newRecordingLogSink()
+		r.Log = logr.New(sink)
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`), map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+
+		entry := sink.find("Updating the OperandConfig")
+		Expect(entry).NotTo(BeNil())
+		Expect(entry.values["change"]).To(Equal("changed ibm-test-operator.spec.testCR.replicas: 1 -> 2"))
+		Expect(entry.values["operandConfig"]).To(Equal(testServicesNs + "/common-service"))
+	})
+})
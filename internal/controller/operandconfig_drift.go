@@ -0,0 +1,110 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// operandConfigDriftPrefix prefixes the name of the requests reconciling the
+// drift of the OperandConfig. A CommonService CR name can't contain "/", so the
+// requests don't collide with the ones of the CRs.
+const operandConfigDriftPrefix = "operandconfig/"
+
+// mappingToDriftRequestForOperandConfig enqueues a drift request when the
+// OperandConfig managed by the operator is edited
+func (r *CommonServiceReconciler) mappingToDriftRequestForOperandConfig() handler.MapFunc {
+	return func(object client.Object) []reconcile.Request {
+		operandConfig, ok := object.(*odlm.OperandConfig)
+		if !ok {
+			// It's not an OperandConfig, ignore
+			return nil
+		}
+		if operandConfig.Name != r.Bootstrap.CSData.OperandConfigName || operandConfig.Namespace != r.Bootstrap.CSData.ServicesNs {
+			return nil
+		}
+		return []reconcile.Request{
+			{NamespacedName: types.NamespacedName{Name: operandConfigDriftPrefix + operandConfig.Name, Namespace: operandConfig.Namespace}},
+		}
+	}
+}
+
+// operandConfigWrittenGenerations keeps the generation of each OperandConfig
+// written by the operator, so the drift predicate skips the operator's own
+// writes
+var operandConfigWrittenGenerations sync.Map
+
+// recordOperandConfigWrite records the generation of the OperandConfig
+// written by the operator
+func recordOperandConfigWrite(opcon client.Object) {
+	operandConfigWrittenGenerations.Store(client.ObjectKeyFromObject(opcon), opcon.GetGeneration())
+}
+
+// isOperandConfigSelfWrite checks if the generation of the OperandConfig is
+// the last one written by the operator
+func isOperandConfigSelfWrite(opcon client.Object) bool {
+	generation, ok := operandConfigWrittenGenerations.Load(client.ObjectKeyFromObject(opcon))
+	return ok && generation.(int64) == opcon.GetGeneration()
+}
+
+// operandConfigDriftPredicate passes the updates of the OperandConfig changing
+// its spec or its freeze annotation, so the updates skipped while it was
+// frozen are merged once it is unfrozen. The spec changes written by the
+// operator itself are not a drift.
+func operandConfigDriftPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			return (e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() && !isOperandConfigSelfWrite(e.ObjectNew)) ||
+				e.ObjectOld.GetAnnotations()[FreezeAnnoKey] != e.ObjectNew.GetAnnotations()[FreezeAnnoKey]
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool { return false },
+	}
+}
+
+// isOperandConfigDriftRequest checks if the request is enqueued by an edit of
+// the OperandConfig instead of a CommonService CR
+func isOperandConfigDriftRequest(req reconcile.Request) bool {
+	return strings.HasPrefix(req.Name, operandConfigDriftPrefix)
+}
+
+// reconcileOperandConfigDrift restores the sizing computed from the
+// CommonService CRs when the OperandConfig is edited out of band. The merge
+// raises the values lowered below the CRs, and the full recompute shrinks the
+// values raised above them. Both skip the update when nothing drifted.
+func (r *CommonServiceReconciler) reconcileOperandConfigDrift(ctx context.Context) error {
+	klog.V(2).Infof("Checking the drift of OperandConfig %s/%s", r.Bootstrap.CSData.ServicesNs, r.Bootstrap.CSData.OperandConfigName)
+	if err := r.ReconcileAll(ctx); err != nil {
+		klog.Errorf("failed to restore the OperandConfig from the CommonService CRs: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("reconcileOperandConfigDrift", func() {
+	var r *CommonServiceReconciler
+
+	BeforeEach(func() {
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+  - name: ibm-licensing-operator
+    spec:
+      IBMLicensing:
+        resources:
+          limits:
+            cpu: 500m
+`)
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+- name: ibm-licensing-operator
+  spec:
+    IBMLicensing:
+      resources:
+        limits:
+          cpu: 500m
+`))
+		r = newTestReconciler(opcon, tenant)
+	})
+
+	It("should only enqueue the edits of the managed OperandConfig", func() {
+		requests := r.mappingToDriftRequestForOperandConfig()(&odlm.OperandConfig{ObjectMeta: metav1.ObjectMeta{Name: "common-service", Namespace: testServicesNs}})
+		Expect(requests).To(HaveLen(1))
+		Expect(isOperandConfigDriftRequest(requests[0])).To(BeTrue())
+		Expect(r.mappingToDriftRequestForOperandConfig()(&odlm.OperandConfig{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testServicesNs}})).To(BeEmpty())
+		Expect(isOperandConfigDriftRequest(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testServicesNs, Name: "common-service"}})).To(BeFalse())
+	})
+
+	It("should restore the sizing computed from the CRs", func() {
+		By("raising the replicas and lowering the cpu out of band")
+		edited := getTestOperandConfig(r, "common-service")
+		services, _, _ := unstructured.NestedSlice(edited.Object, "spec", "services")
+		getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"] = map[string]interface{}{
+			"mongoDB": map[string]interface{}{"replicas": int64(5)},
+		}
+		getItemByName(services, "ibm-licensing-operator").(map[string]interface{})["spec"] = map[string]interface{}{
+			"IBMLicensing": map[string]interface{}{"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "100m"}}},
+		}
+		Expect(unstructured.SetNestedSlice(edited.Object, services, "spec", "services")).To(Succeed())
+		Expect(r.Client.Update(context.TODO(), edited)).To(Succeed())
+
+		By("restoring the sizing on the next reconcile")
+		Expect(r.reconcileOperandConfigDrift(context.TODO())).To(Succeed())
+		restored := getTestOperandConfig(r, "common-service")
+		Expect(getTestServiceSpec(restored, "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(1))
+		cpu, _, _ := unstructured.NestedString(getTestServiceSpec(restored, "ibm-licensing-operator", "IBMLicensing"), "resources", "limits", "cpu")
+		Expect(cpu).To(Equal("500m"))
+
+		By("leaving the OperandConfig untouched without drift")
+		resourceVersion := restored.GetResourceVersion()
+		Expect(r.reconcileOperandConfigDrift(context.TODO())).To(Succeed())
+		Expect(getTestOperandConfig(r, "common-service").GetResourceVersion()).To(Equal(resourceVersion))
+	})
+
+	It("should not enqueue a drift request for the operator's own write", func() {
+		opcon := getTestOperandConfig(r, "common-service")
+		existing, _, _ := unstructured.NestedSlice(opcon.Object, "spec", "services")
+		merged, _, _ := unstructured.NestedSlice(opcon.Object, "spec", "services")
+		getItemByName(merged, "ibm-im-mongodb-operator").(map[string]interface{})["spec"] = map[string]interface{}{
+			"mongoDB": map[string]interface{}{"replicas": int64(3)},
+		}
+		Expect(r.writeOperandConfig(context.TODO(), opcon, existing, merged)).To(Succeed())
+
+		written := getTestOperandConfig(r, "common-service")
+		old := written.DeepCopy()
+		old.SetGeneration(written.GetGeneration() - 1)
+		Expect(operandConfigDriftPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: written})).To(BeFalse())
+
+		By("passing the next edit out of band")
+		edited := written.DeepCopy()
+		edited.SetGeneration(written.GetGeneration() + 1)
+		Expect(operandConfigDriftPredicate().Update(event.UpdateEvent{ObjectOld: written, ObjectNew: edited})).To(BeTrue())
+	})
+})
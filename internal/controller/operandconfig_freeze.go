@@ -0,0 +1,35 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// FreezeAnnoKey is the annotation freezing the OperandConfig, e.g. while
+	// it is tuned by hand in an incident. The services are still merged, but
+	// the OperandConfig is not updated until the annotation is removed.
+	FreezeAnnoKey   = "commonservices.operator.ibm.com/freeze"
+	FreezeAnnoValue = "true"
+)
+
+// isOperandConfigFrozen checks if the OperandConfig is frozen by the freeze
+// annotation
+func isOperandConfigFrozen(opcon *unstructured.Unstructured) bool {
+	return opcon.GetAnnotations()[FreezeAnnoKey] == FreezeAnnoValue
+}
@@ -0,0 +1,132 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// newTestFrozenReconciler returns a reconciler of a frozen OperandConfig with
+// the given mongoDB replicas, and a CR of 3 replicas
+func newTestFrozenReconciler(replicas string) *CommonServiceReconciler {
+	opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: ` + replicas + `
+`))
+	opcon.SetAnnotations(map[string]string{FreezeAnnoKey: FreezeAnnoValue})
+	cs := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+	return newTestReconciler(opcon, cs)
+}
+
+// unfreezeTestOperandConfig removes the freeze annotation of the live
+// OperandConfig
+func unfreezeTestOperandConfig(r *CommonServiceReconciler) {
+	live := getTestOperandConfig(r, "common-service")
+	live.SetAnnotations(nil)
+	ExpectWithOffset(1, r.Client.Update(context.TODO(), live)).To(Succeed())
+}
+
+var _ = Describe("updateOperandConfig of a frozen OperandConfig", func() {
+	var (
+		r          *CommonServiceReconciler
+		writes     *int
+		newConfigs []interface{}
+		mapping    = map[string]string{"profileController": "default"}
+	)
+
+	getReplicas := func() interface{} {
+		return getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]
+	}
+
+	BeforeEach(func() {
+		r = newTestFrozenReconciler("5")
+		writes = countOperandConfigWrites(r)
+		newConfigs = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 7
+`)
+	})
+
+	It("should neither raise nor shrink the OperandConfig", func() {
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+		Expect(*writes).To(Equal(0))
+		Expect(getReplicas()).To(BeEquivalentTo(5))
+	})
+
+	It("should resume the updates once the annotation is removed", func() {
+		unfreezeTestOperandConfig(r)
+		Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+		Expect(*writes).To(Equal(1))
+		Expect(getReplicas()).To(BeEquivalentTo(3))
+
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*writes).To(Equal(2))
+		Expect(getReplicas()).To(BeEquivalentTo(7))
+	})
+})
+
+var _ = Describe("unfreezing the OperandConfig", func() {
+	frozenAnnotations := map[string]string{FreezeAnnoKey: FreezeAnnoValue}
+
+	// newOperandConfig returns the OperandConfig of an unchanged spec
+	newOperandConfig := func(annotations map[string]string) *odlm.OperandConfig {
+		return &odlm.OperandConfig{ObjectMeta: metav1.ObjectMeta{Name: "common-service", Namespace: testServicesNs, Generation: 1, Annotations: annotations}}
+	}
+
+	DescribeTable("should only pass the predicate when the freeze annotation changes",
+		func(oldAnnotations, newAnnotations map[string]string, expected bool) {
+			e := event.UpdateEvent{ObjectOld: newOperandConfig(oldAnnotations), ObjectNew: newOperandConfig(newAnnotations)}
+			Expect(operandConfigDriftPredicate().Update(e)).To(Equal(expected))
+		},
+		Entry("removing the annotation", frozenAnnotations, nil, true),
+		Entry("adding the annotation", nil, frozenAnnotations, true),
+		Entry("adding another annotation", frozenAnnotations, map[string]string{FreezeAnnoKey: FreezeAnnoValue, "other": "value"}, false),
+	)
+
+	It("should enqueue a merge catching up with the CRs", func() {
+		r := newTestFrozenReconciler("1")
+		Expect(r.ReconcileAll(context.TODO())).To(Succeed())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(1))
+
+		unfreezeTestOperandConfig(r)
+		requests := r.mappingToDriftRequestForOperandConfig()(newOperandConfig(nil))
+		Expect(requests).To(HaveLen(1))
+		_, err := r.Reconcile(context.TODO(), requests[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(3))
+	})
+})
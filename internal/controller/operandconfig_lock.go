@@ -0,0 +1,36 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// operandConfigLocks keeps a mutex for each OperandConfig, it serializes the
+// read-merge-write of the concurrent reconciles of the CommonService CRs
+var operandConfigLocks sync.Map
+
+// lockOperandConfig locks the OperandConfig, and returns the function
+// unlocking it
+func lockOperandConfig(key types.NamespacedName) func() {
+	lock, _ := operandConfigLocks.LoadOrStore(key, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
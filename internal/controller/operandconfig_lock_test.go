@@ -0,0 +1,92 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("updateOperandConfig concurrently", func() {
+	It("should serialize the merges and keep both of them", func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+- name: ibm-other-operator
+  spec:
+    otherCR:
+      replicas: 1
+`))
+		r := newTestReconciler(opcon)
+		// Record the most OperandConfig writes in flight at once
+		var inFlight, maxInFlight int32
+		c := newHookClient(r)
+		c.patch = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if isTestOperandConfig(obj) {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+				// Widen the window of the write for the other merge
+				time.Sleep(10 * time.Millisecond)
+			}
+			return c.Client.Patch(ctx, obj, patch, opts...)
+		}
+		mapping := map[string]string{"profileController": "default"}
+
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i, newConfigs := range []string{`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`, `
+- name: ibm-other-operator
+  spec:
+    otherCR:
+      replicas: 3
+`} {
+			wg.Add(1)
+			go func(i int, newConfigs []interface{}) {
+				defer wg.Done()
+				_, errs[i] = r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+			}(i, mustConvertStringToSlice(newConfigs))
+		}
+		wg.Wait()
+
+		// The merges are serialized, and both of them are kept
+		Expect(errs[0]).NotTo(HaveOccurred())
+		Expect(errs[1]).NotTo(HaveOccurred())
+		Expect(atomic.LoadInt32(&maxInFlight)).To(BeEquivalentTo(1))
+		updated := getTestOperandConfig(r, "common-service")
+		Expect(getTestServiceSpec(updated, "ibm-test-operator", "testCR")["replicas"]).To(BeEquivalentTo(2))
+		Expect(getTestServiceSpec(updated, "ibm-other-operator", "otherCR")["replicas"]).To(BeEquivalentTo(3))
+	})
+})
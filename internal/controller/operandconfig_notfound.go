@@ -0,0 +1,57 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// ErrOperandConfigNotFound is returned when the OperandConfig doesn't exist
+// yet, e.g. while ODLM is bootstrapping. The reconcile is requeued after
+// OperandConfigNotFoundRequeueAfter instead of failing.
+var ErrOperandConfigNotFound = errors.New("OperandConfig is not found")
+
+// OperandConfigNotFoundRequeueAfter is the interval to wait for the
+// OperandConfig to be created
+const OperandConfigNotFoundRequeueAfter = 30 * time.Second
+
+// wrapOperandConfigNotFound wraps the NotFound error of getting the
+// OperandConfig into ErrOperandConfigNotFound, the other errors are returned
+// as they are
+func wrapOperandConfigNotFound(opconKey types.NamespacedName, err error) error {
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%w: %s", ErrOperandConfigNotFound, opconKey.String())
+	}
+	return err
+}
+
+// requeueOnOperandConfigNotFound returns the result requeuing the reconcile
+// after the interval when the err is ErrOperandConfigNotFound
+func requeueOnOperandConfigNotFound(err error) (ctrl.Result, bool) {
+	if !errors.Is(err, ErrOperandConfigNotFound) {
+		return ctrl.Result{}, false
+	}
+	klog.Infof("%v, requeue after %s", err, OperandConfigNotFoundRequeueAfter)
+	return ctrl.Result{RequeueAfter: OperandConfigNotFoundRequeueAfter}, true
+}
@@ -0,0 +1,61 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("OperandConfig not found", func() {
+	var r *CommonServiceReconciler
+
+	BeforeEach(func() {
+		r = newTestReconciler()
+	})
+
+	It("should return the sentinel, not a generic error", func() {
+		_, err := r.updateOperandConfig(context.TODO(), nil, map[string]string{"profileController": "default"})
+		Expect(err).To(MatchError(ErrOperandConfigNotFound))
+		err = r.handleDelete(context.TODO(), nil)
+		Expect(err).To(MatchError(ErrOperandConfigNotFound))
+		result, ok := requeueOnOperandConfigNotFound(err)
+		Expect(ok).To(BeTrue())
+		Expect(result.RequeueAfter).To(Equal(OperandConfigNotFoundRequeueAfter))
+	})
+
+	It("should requeue the reconcile after the interval instead of failing", func() {
+		result, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: operandConfigDriftPrefix + "common-service", Namespace: testServicesNs}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(OperandConfigNotFoundRequeueAfter))
+	})
+
+	DescribeTable("should not requeue the other errors",
+		func(err error) {
+			_, ok := requeueOnOperandConfigNotFound(err)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("a generic error", fmt.Errorf("failed to get the OperandConfig")),
+		Entry("no error", nil),
+	)
+})
@@ -0,0 +1,113 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"gomodules.xyz/jsonpatch/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// writeOperandConfig writes the merged services into the OperandConfig. Only
+// spec.services is sent, so the fields other controllers set elsewhere in the
+// OperandConfig since the read aren't overwritten by the in-memory copy. In
+// the JSON patch mode, only the changed paths of the services are sent, so a
+// large OperandConfig isn't transferred in full for a small change. The
+// map-shaped services are always sent in a JSON patch, a merge patch would
+// keep the keys removed from them.
+func (r *CommonServiceReconciler) writeOperandConfig(ctx context.Context, opcon *unstructured.Unstructured, existingServices, opconServices []interface{}) error {
+	// The patch paths start from the services, the OperandConfig without
+	// services gets them in a merge patch
+	_, hasServices, _ := unstructured.NestedFieldNoCopy(opcon.Object, "spec", "services")
+	mapShaped := isMapShapedServices(opcon)
+	var patch client.Patch
+	if (r.Bootstrap.CSData.JSONPatchEnable || mapShaped) && hasServices {
+		var existing, merged interface{} = existingServices, opconServices
+		if mapShaped {
+			existing, merged = servicesSliceToMap(existingServices), servicesSliceToMap(opconServices)
+		}
+		data, err := createServicesPatch(opcon.GetResourceVersion(), existing, merged)
+		if err != nil {
+			return err
+		}
+		patch = client.RawPatch(types.JSONPatchType, data)
+	} else {
+		data, err := createServicesMergePatch(opcon.GetResourceVersion(), opconServices)
+		if err != nil {
+			return err
+		}
+		patch = client.RawPatch(types.MergePatchType, data)
+	}
+	setOperandConfigServices(opcon, opconServices)
+	if err := r.Patch(ctx, opcon, patch); err != nil {
+		return err
+	}
+	recordOperandConfigWrite(opcon)
+	return nil
+}
+
+// createServicesMergePatch creates the JSON merge patch (RFC 7386) replacing
+// the services of the OperandConfig. The patch also sets the resourceVersion
+// read, so it conflicts with the writes since the read like a full update
+// does.
+func createServicesMergePatch(resourceVersion string, opconServices []interface{}) ([]byte, error) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": resourceVersion,
+		},
+		"spec": map[string]interface{}{
+			"services": opconServices,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the merged OperandConfig services: %v", err)
+	}
+	return patch, nil
+}
+
+// createServicesPatch creates the JSON patch (RFC 6902) from the existing
+// services of the OperandConfig to the merged ones. The patch also sets the
+// resourceVersion read, so it conflicts with the writes since the read like a
+// full update does.
+func createServicesPatch(resourceVersion string, existingServices, opconServices interface{}) ([]byte, error) {
+	existingJSON, err := json.Marshal(map[string]interface{}{"services": existingServices})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the existing OperandConfig services: %v", err)
+	}
+	mergedJSON, err := json.Marshal(map[string]interface{}{"services": opconServices})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the merged OperandConfig services: %v", err)
+	}
+	operations, err := jsonpatch.CreatePatch(existingJSON, mergedJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the patch of the OperandConfig services: %v", err)
+	}
+
+	patch := []jsonpatch.Operation{
+		jsonpatch.NewOperation("replace", "/metadata/resourceVersion", resourceVersion),
+	}
+	for _, operation := range operations {
+		operation.Path = "/spec" + operation.Path
+		patch = append(patch, operation)
+	}
+	return json.Marshal(patch)
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mohae/deepcopy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("createServicesPatch", func() {
+	It("should only replace the changed leaf and the resourceVersion", func() {
+		existing := mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 1000m
+          memory: 1Gi
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)
+		merged := deepcopy.Copy(existing).([]interface{})
+		Expect(unstructured.SetNestedField(merged[0].(map[string]interface{}), "2000m", "spec", "mongoDB", "resources", "limits", "cpu")).To(Succeed())
+
+		patch, err := createServicesPatch("42", existing, merged)
+		Expect(err).NotTo(HaveOccurred())
+		var operations []map[string]interface{}
+		Expect(json.Unmarshal(patch, &operations)).To(Succeed())
+		Expect(operations).To(Equal([]map[string]interface{}{
+			{"op": "replace", "path": "/metadata/resourceVersion", "value": "42"},
+			{"op": "replace", "path": "/spec/services/0/spec/mongoDB/resources/limits/cpu", "value": "2000m"},
+		}))
+	})
+})
+
+var _ = Describe("updateOperandConfig with a JSON patch", func() {
+	It("should write the OperandConfig with a JSON patch", func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 1000m
+          memory: 1Gi
+`))
+		r := newTestReconciler(opcon)
+		r.Bootstrap.CSData.JSONPatchEnable = true
+		// Record the patches of the OperandConfig
+		var patches []client.Patch
+		c := newHookClient(r)
+		c.patch = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if isTestOperandConfig(obj) {
+				patches = append(patches, patch)
+			}
+			return c.Client.Patch(ctx, obj, patch, opts...)
+		}
+
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          cpu: 2000m
+`), map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0].Type()).To(Equal(types.JSONPatchType))
+
+		limits, _, _ := unstructured.NestedMap(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB"), "resources", "limits")
+		Expect(limits).To(Equal(map[string]interface{}{"cpu": "2000m", "memory": "1Gi"}))
+	})
+})
+
+var _ = Describe("updateOperandConfig with a concurrent writer", func() {
+	var opcon *unstructured.Unstructured
+
+	BeforeEach(func() {
+		opcon = newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`))
+	})
+
+	DescribeTable("should preserve the fields set by the other writer",
+		func(jsonPatch bool) {
+			// ODLM annotates the OperandConfig and sets another spec key concurrently
+			modify := func(live *unstructured.Unstructured) {
+				live.SetAnnotations(map[string]string{"operator.ibm.com/test": "kept"})
+				_ = unstructured.SetNestedField(live.Object, "kept", "spec", "other")
+			}
+			r := newTestReconciler(opcon)
+			r.Bootstrap.CSData.JSONPatchEnable = jsonPatch
+			// The other writer modifies the OperandConfig before the first write
+			raced := false
+			c := newHookClient(r)
+			c.patch = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				if isTestOperandConfig(obj) && !raced {
+					raced = true
+					if err := modifyOperandConfigBeforeWrite(ctx, c, obj, modify); err != nil {
+						return err
+					}
+				}
+				return c.Client.Patch(ctx, obj, patch, opts...)
+			}
+			_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`), map[string]string{"profileController": "default"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raced).To(BeTrue())
+
+			updated := getTestOperandConfig(r, "common-service")
+			Expect(updated.GetAnnotations()["operator.ibm.com/test"]).To(Equal("kept"))
+			other, _, _ := unstructured.NestedString(updated.Object, "spec", "other")
+			Expect(other).To(Equal("kept"))
+			Expect(getTestServiceSpec(updated, "ibm-test-operator", "testCR")["replicas"]).To(BeEquivalentTo(2))
+		},
+		Entry("with a merge patch", false),
+		Entry("with a JSON patch", true),
+	)
+})
@@ -0,0 +1,1986 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	"github.com/go-logr/logr"
+	certmanagerv1 "github.com/ibm/ibm-cert-manager-operator/apis/cert-manager/v1"
+	"github.com/mohae/deepcopy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/bootstrap"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/constant"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+const testServicesNs = "ibm-common-services"
+
+func newTestReconciler(objs ...client.Object) *CommonServiceReconciler {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = apiv3.AddToScheme(scheme)
+	_ = certmanagerv1.AddToScheme(scheme)
+	// Only the OperandRequests and the OperandRegistries of ODLM are typed,
+	// the OperandConfig is kept unstructured so the malformed services can be
+	// stored
+	scheme.AddKnownTypes(odlm.GroupVersion, &odlm.OperandRequest{}, &odlm.OperandRequestList{}, &odlm.OperandRegistry{}, &odlm.OperandRegistryList{})
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	// The fake clients of the tests reuse the same resourceVersions for the
+	// different CRs, so each test starts with an empty conversion cache
+	csListCache = newUnstructuredListCache(csListCacheSize)
+	return &CommonServiceReconciler{
+		Bootstrap: &bootstrap.Bootstrap{
+			Client: c,
+			Reader: c,
+			CSData: apiv3.CSData{
+				ServicesNs:        testServicesNs,
+				OperatorNs:        testServicesNs,
+				OperandConfigName: "common-service",
+			},
+		},
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+}
+
+// hookClient is the fake client of the tests with hooks on its calls. A hook
+// replaces the call, it calls the wrapped client itself to pass the call on.
+type hookClient struct {
+	client.Client
+	get    func(ctx context.Context, key client.ObjectKey, obj client.Object) error
+	list   func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error
+	update func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error
+	patch  func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error
+}
+
+// newHookClient wraps the client of the reconciler in a hookClient without
+// any hook
+func newHookClient(r *CommonServiceReconciler) *hookClient {
+	c := &hookClient{Client: r.Bootstrap.Client}
+	r.Bootstrap.Client = c
+	return c
+}
+
+func (c *hookClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	if c.get != nil {
+		return c.get(ctx, key, obj)
+	}
+	return c.Client.Get(ctx, key, obj)
+}
+
+func (c *hookClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if c.list != nil {
+		return c.list(ctx, list, opts...)
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
+func (c *hookClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if c.update != nil {
+		return c.update(ctx, obj, opts...)
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *hookClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if c.patch != nil {
+		return c.patch(ctx, obj, patch, opts...)
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+// isTestOperandConfig tells whether the object is the unstructured
+// OperandConfig the reconciler writes
+func isTestOperandConfig(obj client.Object) bool {
+	u, ok := obj.(*unstructured.Unstructured)
+	return ok && u.GetKind() == "OperandConfig"
+}
+
+// countOperandConfigWrites counts the writes of the OperandConfig by the
+// reconciler
+func countOperandConfigWrites(r *CommonServiceReconciler) *int {
+	writes := 0
+	c := newHookClient(r)
+	c.patch = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+		if isTestOperandConfig(obj) {
+			writes++
+		}
+		return c.Client.Patch(ctx, obj, patch, opts...)
+	}
+	return &writes
+}
+
+func newTestOperandConfig(services []interface{}) *unstructured.Unstructured {
+	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	opcon.SetName("common-service")
+	opcon.SetNamespace(testServicesNs)
+	opcon.Object["spec"] = map[string]interface{}{
+		"services": services,
+	}
+	return opcon
+}
+
+func mustConvertStringToSlice(str string) []interface{} {
+	slice, err := convertStringToSlice(str)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "failed to convert fixture")
+	return slice
+}
+
+func getTestOperandConfig(r *CommonServiceReconciler, name string) *unstructured.Unstructured {
+	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	err := r.Reader.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: testServicesNs}, opcon)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "failed to get OperandConfig %s", name)
+	return opcon
+}
+
+func getTestServiceSpec(opcon *unstructured.Unstructured, operator, cr string) map[string]interface{} {
+	services, _, _ := unstructured.NestedSlice(opcon.Object, "spec", "services")
+	service := getItemByName(services, operator)
+	ExpectWithOffset(1, service).NotTo(BeNil(), "operator %s not found in OperandConfig %s", operator, opcon.GetName())
+	return service.(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})
+}
+
+// mustMergeNewConfigs merges the new configs like mergeNewConfigs, failing the
+// spec on an error
+func mustMergeNewConfigs(logger logr.Logger, opconServices, newConfigs, ruleSlice []interface{}, serviceControllerMapping map[string]string, opconNs string, workers int) []interface{} {
+	services, err := mergeNewConfigs(context.TODO(), logger, opconServices, newConfigs, ruleSlice, serviceControllerMapping, opconNs, workers, false)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return services
+}
+
+// mustMergeConfigs merges the configs like MergeConfigs, failing the spec on
+// an error
+func mustMergeConfigs(opconServices []interface{}, csConfigsList [][]interface{}, ruleSlice []interface{}, serviceControllerMapping map[string]string, extreme Extreme, opconNs string) []interface{} {
+	services, err := MergeConfigs(context.TODO(), opconServices, csConfigsList, ruleSlice, serviceControllerMapping, extreme, opconNs)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return services
+}
+
+func newTestCommonService(name, spec string) *unstructured.Unstructured {
+	specSlice, err := convertStringToSlice(spec)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "failed to convert the spec of CommonService %s", name)
+	cs := util.NewUnstructured("operator.ibm.com", "CommonService", "v3")
+	cs.SetName(name)
+	cs.SetNamespace(testServicesNs)
+	cs.Object["spec"] = specSlice[0]
+	return cs
+}
+
+func newTestCommonServiceObject(namespace, name, spec string) *apiv3.CommonService {
+	specSlice, err := convertStringToSlice(spec)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "failed to convert the spec of CommonService %s", name)
+	cs := util.NewUnstructured("operator.ibm.com", "CommonService", "v3")
+	cs.SetName(name)
+	cs.SetNamespace(namespace)
+	cs.Object["spec"] = specSlice[0]
+	csObject := &apiv3.CommonService{}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(cs.Object, csObject)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "failed to convert CommonService %s", name)
+	return csObject
+}
+
+func normalizeTestServices(services []interface{}) []interface{} {
+	normalized, err := normalizeServices(services)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return normalized
+}
+
+// modifyOperandConfigBeforeWrite lets another writer modify the live
+// OperandConfig between the read and the write of the reconciler
+func modifyOperandConfigBeforeWrite(ctx context.Context, c *hookClient, obj client.Object, modify func(*unstructured.Unstructured)) error {
+	live := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	if err := c.Client.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		return err
+	}
+	modify(live)
+	return c.Client.Update(ctx, live)
+}
+
+var _ = Describe("updateOperandConfig metrics", func() {
+	var r *CommonServiceReconciler
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)))
+	})
+
+	It("should count the merges by rules and by default rules per operator", func() {
+		newConfigs := mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`)
+
+		rulesBefore := testutil.ToFloat64(rulesMergeTotal.WithLabelValues("ibm-im-mongodb-operator"))
+		defaultBefore := testutil.ToFloat64(defaultRulesMergeTotal.WithLabelValues("ibm-test-operator"))
+		unexpectedBefore := testutil.ToFloat64(defaultRulesMergeTotal.WithLabelValues("ibm-im-mongodb-operator"))
+
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(testutil.ToFloat64(rulesMergeTotal.WithLabelValues("ibm-im-mongodb-operator"))).To(Equal(rulesBefore + 1))
+		Expect(testutil.ToFloat64(defaultRulesMergeTotal.WithLabelValues("ibm-test-operator"))).To(Equal(defaultBefore + 1))
+		Expect(testutil.ToFloat64(defaultRulesMergeTotal.WithLabelValues("ibm-im-mongodb-operator"))).To(Equal(unexpectedBefore))
+	})
+
+	It("should count the updated and the skipped writes of the OperandConfig", func() {
+		cs := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        replicas: 2
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)), cs)
+		newConfigs := `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`
+		mapping := map[string]string{"profileController": "default"}
+		updatedBefore := testutil.ToFloat64(operandConfigUpdatesTotal.WithLabelValues(UpdateResultUpdated))
+		skippedBefore := testutil.ToFloat64(operandConfigUpdatesTotal.WithLabelValues(UpdateResultSkipped))
+
+		By("counting a genuine change as updated")
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testutil.ToFloat64(operandConfigUpdatesTotal.WithLabelValues(UpdateResultUpdated))).To(Equal(updatedBefore + 1))
+		Expect(testutil.ToFloat64(operandConfigUpdatesTotal.WithLabelValues(UpdateResultSkipped))).To(Equal(skippedBefore))
+		Expect(testutil.ToFloat64(commonServiceCRsProcessed)).To(Equal(float64(1)))
+		Expect(testutil.CollectAndCount(extremeizesDuration, "commonservice_extremeizes_duration_seconds")).To(BeNumerically(">=", 1))
+
+		By("counting a no-op as skipped")
+		_, err = r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testutil.ToFloat64(operandConfigUpdatesTotal.WithLabelValues(UpdateResultUpdated))).To(Equal(updatedBefore + 1))
+		Expect(testutil.ToFloat64(operandConfigUpdatesTotal.WithLabelValues(UpdateResultSkipped))).To(Equal(skippedBefore + 1))
+	})
+})
+
+var _ = Describe("mergeCSCRs", func() {
+	It("should move the renamed keys to their paths in the rules", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  renames:
+    testCR:
+      cpuLimit: resources.limits.cpu
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+		csConfigs := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      cpuLimit: 500m
+      resources:
+        limits:
+          memory: 1Gi
+`)
+
+		summary := mergeCSCRs(logr.Discard(), nil, csConfigs, ruleSlice, map[string]string{"profileController": "default"}, testServicesNs, nil)
+
+		spec := getItemByName(summary, "ibm-test-operator").(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})
+		Expect(spec).NotTo(HaveKey("cpuLimit"))
+		Expect(spec["resources"]).To(Equal(map[string]interface{}{
+			"limits": map[string]interface{}{
+				"cpu":    "500m",
+				"memory": "1Gi",
+			},
+		}))
+	})
+})
+
+var _ = Describe("updateOperandConfig with a custom OperandConfig name", func() {
+	var (
+		r          *CommonServiceReconciler
+		newConfigs []interface{}
+		mapping    = map[string]string{"profileController": "default"}
+	)
+
+	BeforeEach(func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`))
+		opcon.SetName("common-service-instance-2")
+		r = newTestReconciler(opcon)
+		newConfigs = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`)
+	})
+
+	It("should fetch and update the OperandConfig by the configured name", func() {
+		r.Bootstrap.CSData.OperandConfigName = "common-service-instance-2"
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		spec := getTestServiceSpec(getTestOperandConfig(r, "common-service-instance-2"), "ibm-test-operator", "testCR")
+		Expect(spec["replicas"]).To(BeEquivalentTo(2))
+	})
+
+	It("should not find the OperandConfig by the default name", func() {
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject the empty name", func() {
+		r.Bootstrap.CSData.OperandConfigName = ""
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).To(MatchError("the name of the OperandConfig is empty"))
+		Expect(r.handleDelete(context.TODO(), nil)).To(MatchError("the name of the OperandConfig is empty"))
+	})
+})
+
+var _ = Describe("updateOperandConfigWithCondition", func() {
+	It("should track the result of the merge in the ConfigMerged condition", func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`))
+		newConfigs := `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`
+		mapping := map[string]string{"profileController": "default"}
+		instance := &apiv3.CommonService{}
+		getConditions := func() []apiv3.CommonServiceCondition {
+			var conditions []apiv3.CommonServiceCondition
+			for _, c := range instance.Status.Conditions {
+				if c.Type == apiv3.ConditionTypeConfigMerged {
+					conditions = append(conditions, c)
+				}
+			}
+			return conditions
+		}
+
+		By("setting the condition True on a successful merge")
+		r := newTestReconciler(opcon.DeepCopy())
+		_, err := r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		conditions := getConditions()
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Status).To(Equal(corev1.ConditionTrue))
+		Expect(conditions[0].Reason).To(Equal(apiv3.ConditionReasonMerged))
+
+		By("transitioning the condition to False with the error on failure")
+		r = newTestReconciler()
+		_, err = r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).To(HaveOccurred())
+		conditions = getConditions()
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Status).To(Equal(corev1.ConditionFalse))
+		Expect(conditions[0].Reason).To(Equal(apiv3.ConditionReasonMergeFail))
+		Expect(conditions[0].Message).To(Equal(err.Error()))
+
+		By("transitioning the condition back to True once the merge succeeds again")
+		r = newTestReconciler(opcon.DeepCopy())
+		_, err = r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		conditions = getConditions()
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Status).To(Equal(corev1.ConditionTrue))
+	})
+})
+
+var _ = Describe("Replace merge strategy", func() {
+	opconServices := `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 100m
+          memory: 256Mi
+    otherCR:
+      replicas: 1
+`
+	newConfigs := func(strategy string) []interface{} {
+		return mustConvertStringToSlice(fmt.Sprintf(`
+- name: ibm-test-operator
+  mergeStrategy: %s
+  spec:
+    testCR:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 200m
+`, strategy))
+	}
+	mapping := map[string]string{"profileController": "default"}
+
+	It("should re-add the keys deleted from the curated spec under the default merge", func() {
+		services := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices), newConfigs("merge"), nil, mapping, testServicesNs, 1)
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 200m
+          memory: 256Mi
+    otherCR:
+      replicas: 1
+`)))
+	})
+
+	It("should keep the keys deleted from the curated spec deleted under replace", func() {
+		services := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices), newConfigs("replace"), nil, mapping, testServicesNs, 1)
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 200m
+`)))
+	})
+
+	It("should write the CR only in the curated spec", func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`))
+		r := newTestReconciler(opcon)
+
+		// The CR only in the curated spec is written instead of panicking the
+		// comparison with the existing specs
+		isEqual, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-test-operator
+  mergeStrategy: replace
+  spec:
+    newCR:
+      replicas: 2
+`), map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isEqual).To(BeFalse())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-test-operator", "newCR")["replicas"]).To(BeEquivalentTo(2))
+	})
+})
+
+var _ = Describe("mergeNewConfigs with malformed entries", func() {
+	It("should skip the malformed CRs and the malformed duplicates", func() {
+		opconServices := []interface{}{
+			map[string]interface{}{
+				"name": "ibm-test-operator",
+				"spec": map[string]interface{}{
+					"testCR":      map[string]interface{}{"replicas": int64(1)},
+					"malformedCR": "replicas",
+				},
+			},
+		}
+		newConfigs := []interface{}{
+			map[string]interface{}{
+				"name": "ibm-test-operator",
+				"spec": map[string]interface{}{
+					"testCR":      map[string]interface{}{"replicas": int64(2)},
+					"malformedCR": map[string]interface{}{"replicas": int64(2)},
+				},
+			},
+			map[string]interface{}{
+				"name":     "ibm-test-operator",
+				"identity": "ibm-test-operator",
+				"spec": map[string]interface{}{
+					"testCR": "replicas",
+				},
+			},
+		}
+		services := mustMergeNewConfigs(logr.Discard(), opconServices, newConfigs, nil, map[string]string{"profileController": "default"}, testServicesNs, 1)
+		spec := services[0].(map[string]interface{})["spec"].(map[string]interface{})
+		Expect(spec["testCR"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(2))
+		Expect(spec["malformedCR"]).To(Equal("replicas"))
+	})
+})
+
+var _ = Describe("specsEqual", func() {
+	existing := `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+- name: ibm-spec-less-operator
+`
+
+	It("should compare the CR specs of the services", func() {
+		Expect(specsEqual(mustConvertStringToSlice(existing), mustConvertStringToSlice(existing))).To(BeTrue())
+	})
+
+	DescribeTable("should count the missing CR, spec or service as changed",
+		func(merged string) {
+			Expect(specsEqual(mustConvertStringToSlice(existing), mustConvertStringToSlice(merged))).To(BeFalse())
+		},
+		Entry("missing CR", `
+- name: ibm-test-operator
+  spec:
+    otherCR:
+      replicas: 1
+`),
+		Entry("missing spec", `
+- name: ibm-spec-less-operator
+  spec:
+    testCR:
+      replicas: 1
+`),
+		Entry("missing service", `
+- name: ibm-new-operator
+  spec:
+    testCR:
+      replicas: 1
+`),
+	)
+
+	It("should skip the malformed services", func() {
+		Expect(specsEqual(mustConvertStringToSlice(existing), []interface{}{"ibm-test-operator", map[string]interface{}{"spec": "testCR"}})).To(BeTrue())
+	})
+})
+
+var _ = Describe("mergeOperandConfig dry run", func() {
+	var (
+		r          *CommonServiceReconciler
+		newConfigs []interface{}
+	)
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)))
+		newConfigs = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`)
+	})
+
+	It("should return the merged services without updating the OperandConfig", func() {
+		isEqual, services, _, err := r.mergeOperandConfig(context.TODO(), newConfigs, map[string]string{"profileController": "default"}, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(isEqual).To(BeFalse())
+
+		By("returning the merged services")
+		spec := getTestServiceSpec(newTestOperandConfig(services), "ibm-test-operator", "testCR")
+		Expect(spec["replicas"]).To(BeEquivalentTo(2))
+
+		By("leaving the live OperandConfig unchanged")
+		spec = getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-test-operator", "testCR")
+		Expect(spec["replicas"]).To(BeEquivalentTo(1))
+	})
+
+	It("should leave the status, the events and the metrics of the CRs unchanged", func() {
+		turbo := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-test-operator
+    managementStrategy: turbo
+    spec:
+      testCR:
+        replicas: 1
+`)
+		vpa := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-test-operator
+    managementStrategy: vpa
+    spec:
+      testCR:
+        replicas: 1
+`)
+		r = newTestReconciler(getTestOperandConfig(r, "common-service"), turbo, vpa)
+		commonServiceCRsProcessed.Set(-1)
+		defaultBefore := testutil.ToFloat64(defaultRulesMergeTotal.WithLabelValues("ibm-test-operator"))
+
+		_, _, _, err := r.mergeOperandConfig(context.TODO(), newConfigs, map[string]string{"profileController": "default"}, true)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("keeping the profile controller conflicts out of the status of the CRs")
+		Expect(getProfileControllerConflicts(types.NamespacedName{Namespace: "tenant-a", Name: "example-service"})).To(BeEmpty())
+		Expect(getProfileControllerConflicts(types.NamespacedName{Namespace: "tenant-b", Name: "example-service"})).To(BeEmpty())
+
+		By("recording no event")
+		Expect(r.Recorder.(*record.FakeRecorder).Events).To(BeEmpty())
+
+		By("leaving the metrics unchanged")
+		Expect(testutil.ToFloat64(commonServiceCRsProcessed)).To(Equal(float64(-1)))
+		Expect(testutil.ToFloat64(defaultRulesMergeTotal.WithLabelValues("ibm-test-operator"))).To(Equal(defaultBefore))
+	})
+})
+
+var _ = Describe("mergeCRsIntoOperandConfigWithDefaultRules", func() {
+	It("should keep the larger storage quantity across the binary and decimal suffixes", func() {
+		defaultSpec := mustConvertStringToSlice(`
+- storage: 10Gi
+  resources:
+    limits:
+      ephemeral-storage: 2G
+`)[0].(map[string]interface{})
+		changedSpec := mustConvertStringToSlice(`
+- storage: 20G
+  resources:
+    limits:
+      ephemeral-storage: 1Gi
+`)[0].(map[string]interface{})
+
+		merged := mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), defaultSpec, changedSpec, false)
+		Expect(merged["storage"]).To(Equal("20G"))
+		Expect(merged["resources"].(map[string]interface{})["limits"].(map[string]interface{})["ephemeral-storage"]).To(Equal("2G"))
+	})
+
+	It("should keep the larger integers and quantities of the postgres parameters", func() {
+		defaultSpec := mustConvertStringToSlice(`
+- instances: 1
+  postgresql:
+    parameters:
+      max_connections: "200"
+      shared_buffers: 256MB
+`)[0].(map[string]interface{})
+		changedSpec := mustConvertStringToSlice(`
+- instances: 2
+  postgresql:
+    parameters:
+      max_connections: "100"
+      shared_buffers: 512MB
+`)[0].(map[string]interface{})
+
+		merged := mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), defaultSpec, changedSpec, false)
+		Expect(merged["instances"]).To(BeEquivalentTo(2))
+		parameters := merged["postgresql"].(map[string]interface{})["parameters"].(map[string]interface{})
+		Expect(parameters["max_connections"]).To(Equal("200"))
+		Expect(parameters["shared_buffers"]).To(Equal("512MB"))
+	})
+
+	It("should merge the arrays of different lengths by index", func() {
+		defaultSpec := mustConvertStringToSlice(`
+- containers:
+  - name: a
+    cpu: 500m
+  - name: b
+    cpu: "1"
+`)[0].(map[string]interface{})
+		changedSpec := mustConvertStringToSlice(`
+- containers:
+  - name: a
+    cpu: 200m
+  - name: b
+    cpu: "2"
+  - name: c
+    cpu: 100m
+`)[0].(map[string]interface{})
+
+		// The items are merged by index, and the extra item of the changed array is kept
+		merged := mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), defaultSpec, changedSpec, false)
+		containers := merged["containers"].([]interface{})
+		Expect(containers).To(HaveLen(3))
+		Expect(containers[0].(map[string]interface{})["cpu"]).To(Equal("500m"))
+		Expect(containers[1].(map[string]interface{})["cpu"]).To(Equal("2"))
+		Expect(containers[2]).To(Equal(map[string]interface{}{"name": "c", "cpu": "100m"}))
+
+		// The items missing from the shorter changed array are appended from the default array
+		defaultSpec = changedSpec
+		changedSpec = mustConvertStringToSlice(`
+- containers:
+  - name: a
+    cpu: 800m
+  - name: b
+    cpu: "1"
+`)[0].(map[string]interface{})
+		merged = mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), defaultSpec, changedSpec, true)
+		containers = merged["containers"].([]interface{})
+		Expect(containers).To(HaveLen(3))
+		Expect(containers[0].(map[string]interface{})["cpu"]).To(Equal("800m"))
+		Expect(containers[1].(map[string]interface{})["cpu"]).To(Equal("1"))
+		Expect(containers[2].(map[string]interface{})["cpu"]).To(Equal("100m"))
+
+		// A changed item which is not an object is kept as it is
+		merged = mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), map[string]interface{}{
+			"containers": []interface{}{map[string]interface{}{"cpu": "1"}},
+		}, map[string]interface{}{
+			"containers": []interface{}{"a"},
+		}, false)
+		Expect(merged["containers"]).To(Equal([]interface{}{"a"}))
+	})
+})
+
+var _ = Describe("getItemByIdentity", func() {
+	It("should match the names regardless of the case and the surrounding spaces", func() {
+		slice := mustConvertStringToSlice(`
+- name: ibm-zen-operator
+- name: ibm-zen-operator-v2
+`)
+		for _, name := range []string{"ibm-zen-operator", "Ibm-Zen-Operator", " ibm-zen-operator ", "IBM-ZEN-OPERATOR\t"} {
+			item := getItemByIdentity(slice, map[string]interface{}{"name": name})
+			Expect(item).NotTo(BeNil(), name)
+			Expect(item.(map[string]interface{})["name"]).To(Equal("ibm-zen-operator"), name)
+		}
+		Expect(getItemByIdentity(slice, map[string]interface{}{"name": "Ibm-Zen-Operator-V2"}).(map[string]interface{})["name"]).To(Equal("ibm-zen-operator-v2"))
+		Expect(getItemByIdentity(slice, map[string]interface{}{"name": "ibm-zen-operator-v3"})).To(BeNil())
+		Expect(getItemByIdentity(slice, map[string]interface{}{"name": "ibm zen operator"})).To(BeNil())
+	})
+
+	It("should let the case variant of the CR override the OperandConfig entry", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-zen-operator
+  spec:
+    zenService:
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+`)
+		opconServices := mustConvertStringToSlice(`
+- name: ibm-zen-operator
+  spec:
+    zenService:
+      resources:
+        limits:
+          cpu: 100m
+- name: ibm-zen-operator-v2
+  spec:
+    zenService:
+      resources:
+        limits:
+          cpu: 100m
+`)
+		csConfigs := mustConvertStringToSlice(`
+- name: " Ibm-Zen-Operator "
+  spec:
+    zenService:
+      resources:
+        limits:
+          cpu: 500m
+`)
+		services := mustMergeConfigs(opconServices, [][]interface{}{csConfigs}, ruleSlice, map[string]string{"profileController": "default"}, Max, testServicesNs)
+		cpu := func(name string) interface{} {
+			spec := getItemByName(services, name).(map[string]interface{})["spec"].(map[string]interface{})
+			return spec["zenService"].(map[string]interface{})["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"]
+		}
+		Expect(cpu("ibm-zen-operator")).To(Equal("500m"))
+		Expect(cpu("ibm-zen-operator-v2")).To(Equal("100m"))
+	})
+})
+
+var _ = Describe("getItemByGVKNameNamespace", func() {
+	var opResources []interface{}
+
+	BeforeEach(func() {
+		opResources = mustConvertStringToSlice(`
+- apiVersion: apps/v1
+  name: test-deployment
+- apiVersion: apps/v1
+  kind: Deployment
+  name: 1
+- apiVersion: apps/v1
+  kind: Deployment
+- not-a-resource
+- apiVersion: apps/v1
+  kind: Deployment
+  name: test-deployment
+  data:
+    spec:
+      replicas: 2
+- apiVersion: apps/v1
+  kind: Deployment
+  name: test-deployment
+  namespace: other
+  data:
+    spec:
+      replicas: 3
+`)
+	})
+
+	DescribeTable("should skip the partial entries",
+		func(kind, name, namespace string, replicas interface{}) {
+			var resource interface{}
+			Expect(func() {
+				resource = getItemByGVKNameNamespace(opResources, testServicesNs, "apps/v1", kind, name, namespace)
+			}).NotTo(Panic())
+			if replicas == nil {
+				Expect(resource).To(BeNil())
+				return
+			}
+			value, _, _ := unstructured.NestedFieldNoCopy(resource.(map[string]interface{}), "data", "spec", "replicas")
+			Expect(value).To(Equal(replicas))
+		},
+		Entry("match in OperandConfig namespace", "Deployment", "test-deployment", testServicesNs, float64(2)),
+		Entry("match in other namespace", "Deployment", "test-deployment", "other", float64(3)),
+		Entry("no match for missing kind", "", "test-deployment", testServicesNs, nil),
+		Entry("no match for unknown name", "Deployment", "unknown", testServicesNs, nil),
+	)
+})
+
+var _ = Describe("mergeCSCRs with malformed entries", func() {
+	goodEntry := map[string]interface{}{
+		"name": "ibm-test-operator",
+		"spec": map[string]interface{}{
+			"testCR": map[string]interface{}{"replicas": int64(3)},
+		},
+	}
+	var ruleSlice []interface{}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+`)
+	})
+
+	DescribeTable("should skip the malformed entry and merge the others",
+		func(badEntry interface{}) {
+			csConfig := []interface{}{badEntry, goodEntry}
+			var summary []interface{}
+			Expect(func() {
+				// Merge twice, so the malformed entries meet the summary
+				summary = mergeCSCRs(logr.Discard(), nil, deepcopy.Copy(csConfig).([]interface{}), ruleSlice, map[string]string{"profileController": "default"}, testServicesNs, nil)
+				summary = mergeCSCRs(logr.Discard(), summary, deepcopy.Copy(csConfig).([]interface{}), ruleSlice, map[string]string{"profileController": "default"}, testServicesNs, nil)
+			}).NotTo(Panic())
+			good := getItemByName(summary, "ibm-test-operator")
+			Expect(good).NotTo(BeNil())
+			Expect(good.(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(3))
+		},
+		Entry("operator is not an object", "ibm-bad-operator"),
+		Entry("name is missing", map[string]interface{}{"spec": map[string]interface{}{}}),
+		Entry("name is not a string", map[string]interface{}{"name": int64(1), "spec": map[string]interface{}{}}),
+		Entry("spec is not an object", map[string]interface{}{"name": "ibm-bad-operator", "spec": "small"}),
+		Entry("CR spec is not an object", map[string]interface{}{"name": "ibm-bad-operator", "spec": map[string]interface{}{"badCR": "small"}}),
+		Entry("resources is not a list", map[string]interface{}{"name": "ibm-bad-operator", "resources": "deployment"}),
+		Entry("resource is not an object", map[string]interface{}{"name": "ibm-bad-operator", "resources": []interface{}{"deployment"}}),
+		Entry("resource has no kind", map[string]interface{}{"name": "ibm-bad-operator", "resources": []interface{}{
+			map[string]interface{}{"apiVersion": "apps/v1", "name": "test"},
+		}}),
+	)
+})
+
+var _ = Describe("mergeConfigs", func() {
+	var (
+		ruleSlice     []interface{}
+		csConfigsList [][]interface{}
+	)
+	opconServices := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 500m
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: mongodb-config
+    data:
+      data:
+        size: 2
+`
+	spec := func(services []interface{}) map[string]interface{} {
+		return getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+	}
+	resource := func(services []interface{}) map[string]interface{} {
+		return getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})
+	}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: mongodb-config
+    data:
+      data:
+        size: LARGEST_VALUE
+`)
+		csConfigsList = [][]interface{}{
+			mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: "1"
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: mongodb-config
+    namespace: ibm-common-services
+    data:
+      data:
+        size: 3
+`),
+			mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: 200m
+`),
+		}
+	})
+
+	It("should keep the largest values of the CRs and the OperandConfig", func() {
+		services := mustMergeConfigs(mustConvertStringToSlice(opconServices), csConfigsList, ruleSlice, map[string]string{}, Max, testServicesNs)
+		Expect(spec(services)["replicas"]).To(BeEquivalentTo(3))
+		Expect(spec(services)["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"]).To(Equal("1"))
+		Expect(resource(services)["data"].(map[string]interface{})["data"].(map[string]interface{})["size"]).To(BeEquivalentTo(3))
+	})
+
+	It("should keep the smallest values when shrinking", func() {
+		services := mustMergeConfigs(mustConvertStringToSlice(opconServices), csConfigsList, ruleSlice, nil, Min, testServicesNs)
+		Expect(spec(services)["replicas"]).To(BeEquivalentTo(2))
+		Expect(spec(services)["resources"].(map[string]interface{})["limits"].(map[string]interface{})["cpu"]).To(Equal("500m"))
+	})
+
+	It("should not merge the resources in another namespace", func() {
+		services := mustMergeConfigs(mustConvertStringToSlice(opconServices), csConfigsList, ruleSlice, nil, Max, "other-namespace")
+		Expect(resource(services)["data"].(map[string]interface{})["data"].(map[string]interface{})["size"]).To(BeEquivalentTo(2))
+	})
+})
+
+var _ = Describe("OperandConfig update conflicts", func() {
+	var (
+		r *CommonServiceReconciler
+		// The number of the writes to fail with a conflict
+		conflicts int
+	)
+	// Another writer scales the other operator concurrently
+	modify := func(live *unstructured.Unstructured) {
+		services, _, _ := unstructured.NestedSlice(live.Object, "spec", "services")
+		getItemByName(services, "ibm-other-operator").(map[string]interface{})["spec"].(map[string]interface{})["otherCR"].(map[string]interface{})["replicas"] = int64(3)
+		_ = unstructured.SetNestedSlice(live.Object, services, "spec", "services")
+	}
+
+	BeforeEach(func() {
+		// The CR shrinks the mongodb operator once the deletion is handled
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+- name: ibm-other-operator
+  spec:
+    otherCR:
+      replicas: 1
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+`)), tenant)
+		// The write conflicts after the other writer modified the OperandConfig
+		conflicts = 1
+		c := newHookClient(r)
+		c.patch = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if !isTestOperandConfig(obj) || conflicts == 0 {
+				return c.Client.Patch(ctx, obj, patch, opts...)
+			}
+			conflicts--
+			if err := modifyOperandConfigBeforeWrite(ctx, c, obj, modify); err != nil {
+				return err
+			}
+			return apierrors.NewConflict(schema.GroupResource{Group: "operator.ibm.com", Resource: "operandconfigs"}, obj.GetName(), fmt.Errorf("the object has been modified"))
+		}
+	})
+
+	It("should retry the merge and keep the concurrent change", func() {
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`), map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conflicts).To(BeZero())
+		updated := getTestOperandConfig(r, "common-service")
+		Expect(getTestServiceSpec(updated, "ibm-test-operator", "testCR")["replicas"]).To(BeEquivalentTo(2))
+		Expect(getTestServiceSpec(updated, "ibm-other-operator", "otherCR")["replicas"]).To(BeEquivalentTo(3))
+	})
+
+	It("should retry the deletion", func() {
+		Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+		Expect(conflicts).To(BeZero())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(1))
+	})
+})
+
+var _ = Describe("extremeizeServices shrinking", func() {
+	It("should only shrink the parameters with the LARGEST_VALUE rule", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: common-service-postgresql
+  resources:
+  - apiVersion: postgresql.k8s.enterprisedb.io/v1
+    kind: Cluster
+    name: common-service-db
+    data:
+      spec:
+        instances: LARGEST_VALUE
+        resources:
+          limits:
+            cpu: LARGEST_VALUE
+`)
+		opconServices := mustConvertStringToSlice(`
+- name: common-service-postgresql
+  resources:
+  - apiVersion: postgresql.k8s.enterprisedb.io/v1
+    kind: Cluster
+    name: common-service-db
+    data:
+      spec:
+        instances: 3
+        resources:
+          limits:
+            cpu: "2"
+            memory: 4Gi
+  - apiVersion: v1
+    kind: ConfigMap
+    name: common-service-db-config
+    data:
+      data:
+        size: 3
+`)
+		csConfigs := mustConvertStringToSlice(`
+- name: common-service-postgresql
+  resources:
+  - apiVersion: postgresql.k8s.enterprisedb.io/v1
+    kind: Cluster
+    name: common-service-db
+    data:
+      spec:
+        instances: 1
+        resources:
+          limits:
+            cpu: "1"
+            memory: 1Gi
+  - apiVersion: v1
+    kind: ConfigMap
+    name: common-service-db-config
+    data:
+      data:
+        size: 1
+`)
+
+		services := mustMergeConfigs(opconServices, [][]interface{}{csConfigs}, ruleSlice, map[string]string{"profileController": "default"}, Min, testServicesNs)
+
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: common-service-postgresql
+  resources:
+  - apiVersion: postgresql.k8s.enterprisedb.io/v1
+    kind: Cluster
+    name: common-service-db
+    data:
+      spec:
+        instances: 1
+        resources:
+          limits:
+            cpu: "1"
+            memory: 4Gi
+  - apiVersion: v1
+    kind: ConfigMap
+    name: common-service-db-config
+    data:
+      data:
+        size: 3
+`)))
+	})
+})
+
+var _ = Describe("updateOperandConfig with malformed services", func() {
+	newConfigs := `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`
+	mapping := map[string]string{"profileController": "default"}
+
+	DescribeTable("should handle the malformed spec of the OperandConfig",
+		func(spec interface{}, expectedErr string) {
+			opcon := newTestOperandConfig(nil)
+			delete(opcon.Object, "spec")
+			if spec != nil {
+				opcon.Object["spec"] = spec
+			}
+
+			r := newTestReconciler(opcon)
+			_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+			deleteErr := r.handleDelete(context.TODO(), nil)
+			if expectedErr != "" {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(deleteErr).To(MatchError(expectedErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleteErr).NotTo(HaveOccurred())
+			services, err := getOperandConfigServices(getTestOperandConfig(r, "common-service"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(BeEmpty())
+		},
+		Entry("no spec", nil, ""),
+		Entry("spec without services", map[string]interface{}{}, ""),
+		Entry("null services", map[string]interface{}{"services": nil}, ""),
+		Entry("spec not an object", "services", "the spec of OperandConfig ibm-common-services/common-service is not an object"),
+		Entry("services not a list", map[string]interface{}{"services": "ibm-test-operator"}, "the services of OperandConfig ibm-common-services/common-service are not a list or a map"),
+		Entry("service in the map not an object", map[string]interface{}{"services": map[string]interface{}{"ibm-test-operator": "testCR"}}, "the services of OperandConfig ibm-common-services/common-service are invalid: the service ibm-test-operator is not an object"),
+	)
+
+	// The malformed services are skipped by every stage of the merge, and kept
+	// as they are next to the merged services
+	DescribeTable("should keep the malformed services as they are",
+		func(service interface{}) {
+			master := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+  - name: ibm-im-mongodb-operator-v4.0
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+			tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 2
+`)
+			for _, profileController := range []string{"", "turbo"} {
+				By("merging with the profile controller " + profileController)
+				opcon := newTestOperandConfig([]interface{}{
+					deepcopy.Copy(service),
+					map[string]interface{}{
+						"name": "ibm-im-mongodb-operator",
+						"spec": map[string]interface{}{"mongoDB": map[string]interface{}{"replicas": int64(1)}},
+					},
+				})
+				tenantCR := tenant.DeepCopy()
+				tenantCR.Spec.ProfileController = profileController
+
+				r := newTestReconciler(opcon, master.DeepCopy(), tenantCR)
+				r.Bootstrap.CSData.MergeWorkers = 2
+				r.Bootstrap.CSData.MasterWinsEnable = true
+				r.Bootstrap.CSData.PDBCheckEnable = true
+				r.Bootstrap.CSData.CPUStripEventEnable = true
+				_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+
+				services, err := getOperandConfigServices(getTestOperandConfig(r, "common-service"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(services).To(ContainElement(service))
+				if profileController == "" {
+					Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(3))
+				}
+			}
+		},
+		Entry("service not an object", "ibm-test-operator"),
+		Entry("service without a name", map[string]interface{}{"spec": map[string]interface{}{"testCR": map[string]interface{}{"replicas": int64(1)}}}),
+		Entry("service name not a string", map[string]interface{}{"name": int64(1), "spec": map[string]interface{}{"testCR": map[string]interface{}{"replicas": int64(1)}}}),
+		Entry("service spec not an object", map[string]interface{}{"name": "ibm-test-operator", "spec": "oops"}),
+		Entry("service CR not an object", map[string]interface{}{"name": "ibm-im-mongodb-operator-v4.0", "spec": map[string]interface{}{"mongoDB": "oops"}}),
+		Entry("service resources not a list", map[string]interface{}{"name": "ibm-test-operator", "resources": "oops"}),
+	)
+})
+
+var _ = Describe("getExtremeizes filtered by namespace", func() {
+	var (
+		ruleSlice []interface{}
+		r         *CommonServiceReconciler
+	)
+	opconServices := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`
+	csSpec := func(replicas int) string {
+		return fmt.Sprintf(`
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: %d
+`, replicas)
+	}
+	replicas := func() interface{} {
+		services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})["replicas"]
+	}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+`)
+		inScope := newTestCommonServiceObject("tenant-a", "example-service", csSpec(2))
+		outOfScope := newTestCommonServiceObject("other-tenant", "example-service", csSpec(5))
+		r = newTestReconciler(inScope, outOfScope)
+		r.Bootstrap.CSData.WatchNamespaces = testServicesNs + ",tenant-a"
+		r.Bootstrap.CSData.FilterByNamespace = true
+	})
+
+	It("should only merge the CRs in the watched namespaces", func() {
+		Expect(replicas()).To(BeEquivalentTo(2))
+	})
+
+	It("should merge all the CRs without the filter", func() {
+		r.Bootstrap.CSData.FilterByNamespace = false
+		Expect(replicas()).To(BeEquivalentTo(5))
+	})
+
+	It("should merge all the CRs when no namespace is watched", func() {
+		r.Bootstrap.CSData.WatchNamespaces = ""
+		Expect(replicas()).To(BeEquivalentTo(5))
+	})
+})
+
+var _ = Describe("updateOperandConfig stability", func() {
+	var r *CommonServiceReconciler
+	newConfigs := `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+      labels:
+        team: a
+        app: test
+`
+	mapping := map[string]string{"profileController": "default"}
+	serialize := func() []byte {
+		services, _, _ := unstructured.NestedSlice(getTestOperandConfig(r, "common-service").Object, "spec", "services")
+		serialized, err := json.Marshal(services)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return serialized
+	}
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+      resources:
+        limits:
+          memory: 1Gi
+          cpu: 500m
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`)))
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should sort the operators by name", func() {
+		services, _, _ := unstructured.NestedSlice(getTestOperandConfig(r, "common-service").Object, "spec", "services")
+		Expect(serviceNames(services)).To(Equal([]string{"ibm-im-mongodb-operator", "ibm-test-operator"}))
+	})
+
+	It("should produce the identical OperandConfig without updating it when merging again", func() {
+		first := serialize()
+		resourceVersion := getTestOperandConfig(r, "common-service").GetResourceVersion()
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(serialize())).To(Equal(string(first)))
+		Expect(getTestOperandConfig(r, "common-service").GetResourceVersion()).To(Equal(resourceVersion))
+	})
+})
+
+var _ = Describe("convertStringToSlice", func() {
+	It("should wrap the error of an invalid YAML", func() {
+		_, err := convertStringToSlice(`
+- name: ibm-test-operator
+  spec: [testCR
+`)
+		Expect(err).To(MatchError(ErrRulesYAML))
+		Expect(err).NotTo(MatchError(ErrRulesUnmarshal))
+		Expect(err.Error()).To(ContainSubstring("line 3"))
+	})
+
+	It("should wrap the error of a valid YAML which is not a list", func() {
+		_, err := convertStringToSlice(`
+name: ibm-test-operator
+`)
+		Expect(err).To(MatchError(ErrRulesUnmarshal))
+		Expect(err).NotTo(MatchError(ErrRulesYAML))
+		var typeErr *json.UnmarshalTypeError
+		Expect(errors.As(err, &typeErr)).To(BeTrue())
+	})
+
+	It("should convert a list", func() {
+		_, err := convertStringToSlice(`
+- name: ibm-test-operator
+`)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("getExtremeizes with a cancelled context", func() {
+	It("should stop before touching the OperandConfig services", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+`)
+		opconServices := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        replicas: 3
+`)
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+
+		// The merge stops before touching the OperandConfig services
+		r := newTestReconciler(tenant)
+		services, err := r.getExtremeizes(ctx, opconServices, ruleSlice, Max)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(services).To(BeEmpty())
+		Expect(opconServices[0].(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(1))
+
+		services, err = extremeizeServices(ctx, logr.Discard(), nil, opconServices, [][]interface{}{mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 3
+`)}, ruleSlice, map[string]string{"profileController": "default"}, Max, testServicesNs, 1)
+		Expect(err).To(MatchError(context.Canceled))
+		Expect(services).To(BeNil())
+		Expect(opconServices[0].(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(1))
+	})
+})
+
+var _ = Describe("mergeConfigs with a wildcard namespace", func() {
+	var (
+		ruleSlice     []interface{}
+		opconServices = `
+- name: ibm-test-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: tenant-config
+    namespace: tenant-a
+    data:
+      data:
+        size: 1
+  - apiVersion: v1
+    kind: ConfigMap
+    name: tenant-config
+    namespace: tenant-b
+    data:
+      data:
+        size: 5
+`
+		wildcard = `
+- name: ibm-test-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: tenant-config
+    namespace: "*"
+    data:
+      data:
+        size: 3
+`
+	)
+
+	sizes := func(services []interface{}) map[string]interface{} {
+		sizes := map[string]interface{}{}
+		for _, resource := range getItemByName(services, "ibm-test-operator").(map[string]interface{})["resources"].([]interface{}) {
+			resourceMap := resource.(map[string]interface{})
+			sizes[resourceMap["namespace"].(string)] = resourceMap["data"].(map[string]interface{})["data"].(map[string]interface{})["size"]
+		}
+		return sizes
+	}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: tenant-config
+    data:
+      data:
+        size: LARGEST_VALUE
+`)
+	})
+
+	It("should merge each resource independently against the wildcard resource", func() {
+		services := mustMergeConfigs(mustConvertStringToSlice(opconServices), [][]interface{}{mustConvertStringToSlice(wildcard)}, ruleSlice, map[string]string{}, Max, testServicesNs)
+		Expect(sizes(services)).To(Equal(map[string]interface{}{"tenant-a": 3.0, "tenant-b": 5.0}))
+	})
+
+	It("should let the resource in the namespace itself win over the wildcard resource", func() {
+		exact := mustConvertStringToSlice(wildcard)
+		exact[0].(map[string]interface{})["resources"] = append(exact[0].(map[string]interface{})["resources"].([]interface{}), map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"name":       "tenant-config",
+			"namespace":  "tenant-a",
+			"data":       map[string]interface{}{"data": map[string]interface{}{"size": 2.0}},
+		})
+		services := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices), exact, ruleSlice, map[string]string{}, testServicesNs, 1)
+		Expect(sizes(services)).To(Equal(map[string]interface{}{"tenant-a": 2.0, "tenant-b": 3.0}))
+	})
+
+	It("should match the wildcard resource as a copy in the namespace", func() {
+		resources := mustConvertStringToSlice(wildcard)[0].(map[string]interface{})["resources"].([]interface{})
+		resource := getItemByGVKNameNamespace(resources, testServicesNs, "v1", "ConfigMap", "tenant-config", "tenant-b")
+		Expect(resource.(map[string]interface{})["namespace"]).To(Equal("tenant-b"))
+		Expect(getItemByGVKNameNamespace(resources, testServicesNs, "v1", "ConfigMap", "other-config", "tenant-b")).To(BeNil())
+	})
+})
+
+var _ = Describe("updateOperandConfigWithCondition of a terminating CR", func() {
+	It("should remove the sizing of the terminating CR instead of merging it", func() {
+		// The OperandConfig was raised by the CR before it started terminating
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 5
+`))
+		terminating := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 5
+`)
+		now := metav1.Now()
+		terminating.SetDeletionTimestamp(&now)
+		terminating.SetFinalizers([]string{"example.com/finalizer"})
+		other := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 2
+`)
+		r := newTestReconciler(opcon, terminating, other)
+		mapping := map[string]string{"profileController": "default"}
+
+		getReplicas := func() interface{} {
+			return getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]
+		}
+
+		// The reconcile of the terminating CR removes its sizing instead of merging it
+		instance := &apiv3.CommonService{}
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Namespace: "tenant-a", Name: "example-service"}, instance)).To(Succeed())
+		Expect(instance.GetDeletionTimestamp()).NotTo(BeNil())
+		_, err := r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 5
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas()).To(BeEquivalentTo(2))
+
+		By("keeping it removed on the reconciles of the other CRs")
+		instance = &apiv3.CommonService{}
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Namespace: "tenant-b", Name: "example-service"}, instance)).To(Succeed())
+		_, err = r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 2
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReplicas()).To(BeEquivalentTo(2))
+	})
+})
+
+var _ = Describe("filterChangedMapWithRules", func() {
+	It("should drop the keys without a rule and log them by their full path", func() {
+		sink := newRecordingLogSink()
+
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-zen-operator
+  spec:
+    zen:
+      replicas: LARGEST_VALUE
+      resources:
+        memory: LARGEST_VALUE
+`)
+		csConfigs := mustConvertStringToSlice(`
+- name: ibm-zen-operator
+  spec:
+    zen:
+      replicas: 2
+      resources:
+        cpu: "2"
+        memory: 2Gi
+      extra:
+        enabled: true
+`)
+
+		summary := mergeCSCRs(logr.New(sink), nil, csConfigs, ruleSlice, map[string]string{"profileController": "default"}, testServicesNs, nil)
+		Expect(getItemByName(summary, "ibm-zen-operator").(map[string]interface{})["spec"].(map[string]interface{})["zen"]).To(Equal(map[string]interface{}{
+			"replicas":  2.0,
+			"resources": map[string]interface{}{"memory": "2Gi"},
+		}))
+
+		// Every dropped key is logged by its full path, the permitted ones aren't
+		var paths []interface{}
+		for _, entry := range sink.findAll("Dropping the key, because no rule permits it") {
+			paths = append(paths, entry.values["path"])
+		}
+		Expect(paths).To(ConsistOf("ibm-zen-operator.spec.zen.resources.cpu", "ibm-zen-operator.spec.zen.extra"))
+	})
+})
+
+var _ = Describe("getExtremeizes when all the CRs terminate", func() {
+	var (
+		r             *CommonServiceReconciler
+		ruleSlice     []interface{}
+		opconServices = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: "2"
+          memory: 4Gi
+`
+	)
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+		now := metav1.Now()
+		var objs []client.Object
+		for _, ns := range []string{"tenant-a", "tenant-b"} {
+			cs := newTestCommonServiceObject(ns, "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+			cs.SetDeletionTimestamp(&now)
+			cs.SetFinalizers([]string{"example.com/finalizer"})
+			objs = append(objs, cs)
+		}
+		r = newTestReconciler(append(objs, newTestOperandConfig(mustConvertStringToSlice(opconServices)))...)
+	})
+
+	DescribeTable("should keep the sizing of the operands on the mass teardown",
+		func(extreme Extreme) {
+			services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, extreme)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(Equal(mustConvertStringToSlice(opconServices)))
+		},
+		Entry("merged by the largest value", Max),
+		Entry("merged by the smallest value", Min),
+		Entry("merged by the average", Avg),
+		Entry("merged by the sum", Sum),
+	)
+
+	It("should keep the sizing of the OperandConfig on the delete", func() {
+		Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+		spec := getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")
+		Expect(spec["replicas"]).To(BeEquivalentTo(3))
+		limits, _, _ := unstructured.NestedMap(spec, "resources", "limits")
+		Expect(limits).To(Equal(map[string]interface{}{"cpu": "2", "memory": "4Gi"}))
+	})
+})
+
+var _ = Describe("merging resources with non-object data", func() {
+	var (
+		ruleSlice     []interface{}
+		opconServices = `
+- name: ibm-test-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: string-config
+    data: size=1
+  - apiVersion: v1
+    kind: ConfigMap
+    name: list-config
+    data:
+    - size
+    - 1
+  - not an object
+`
+		csConfigs = `
+- name: ibm-test-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: string-config
+    data:
+    - size
+    - 2
+  - apiVersion: v1
+    kind: ConfigMap
+    name: list-config
+    data: size=2
+`
+	)
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: string-config
+    data:
+      data:
+        size: LARGEST_VALUE
+  - apiVersion: v1
+    kind: ConfigMap
+    name: list-config
+    data:
+      data:
+        size: LARGEST_VALUE
+`)
+	})
+
+	It("should only find the resources of an object data", func() {
+		valid := map[string]interface{}{"data": map[string]interface{}{"spec": map[string]interface{}{"resources": map[string]interface{}{}}}}
+		Expect(isOpResourceExists(valid)).To(BeTrue())
+		Expect(isOpResourceExists(map[string]interface{}{"data": "size: 2"})).To(BeFalse())
+		Expect(isOpResourceExists(map[string]interface{}{"data": []interface{}{"size", int64(2)}})).To(BeFalse())
+		Expect(isOpResourceExists(map[string]interface{}{"data": map[string]interface{}{"spec": []interface{}{}}})).To(BeFalse())
+		Expect(isOpResourceExists("not an object")).To(BeFalse())
+	})
+
+	// The external profile controllers strip the cpu limits
+	DescribeTable("should merge the data of the other shapes without a panic",
+		func(controller string) {
+			mapping := map[string]string{"profileController": controller}
+			for _, extreme := range []Extreme{Max, Min} {
+				Expect(func() {
+					mustMergeConfigs(mustConvertStringToSlice(opconServices), [][]interface{}{mustConvertStringToSlice(csConfigs), mustConvertStringToSlice(csConfigs)}, ruleSlice, mapping, extreme, testServicesNs)
+				}).NotTo(Panic(), string(extreme))
+			}
+			Expect(func() {
+				mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices), mustConvertStringToSlice(csConfigs), ruleSlice, mapping, testServicesNs, 1)
+			}).NotTo(Panic())
+			Expect(func() {
+				stripCPULimit(logr.Discard(), map[string]interface{}{"data": "size=1"}, "ibm-test-operator", controller)
+			}).NotTo(Panic())
+		},
+		Entry("for the default profile controller", "default"),
+		Entry("for an external profile controller", "turbonomic"),
+	)
+})
+
+var _ = Describe("getExtremeizes with a cloned CommonService", func() {
+	var (
+		r             *CommonServiceReconciler
+		ruleSlice     []interface{}
+		opconServices = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`
+	)
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+`)
+		original := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		cloned := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 5
+`)
+		cloned.SetLabels(map[string]string{constant.CsClonedFromLabel: "tenant-a"})
+		r = newTestReconciler(original, cloned)
+	})
+
+	DescribeTable("should only merge the cloned CR when it is included",
+		func(includeClonedCRs bool, expectedCRs int, expectedReplicas int) {
+			r.Bootstrap.CSData.IncludeClonedCRs = includeClonedCRs
+			activeCRs, err := r.listActiveCommonServices(context.TODO(), logr.Discard())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeCRs).To(HaveLen(expectedCRs))
+			services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services[0].(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(expectedReplicas))
+		},
+		Entry("excluding the cloned CR by default", false, 1, 3),
+		Entry("including the cloned CR", true, 2, 5),
+	)
+})
+
+var _ = Describe("mergeChangedMap with a missing slice", func() {
+	var defaultSpec map[string]interface{}
+
+	BeforeEach(func() {
+		defaultSpec = mustConvertStringToSlice(`
+- containers:
+  - name: first
+    memory: 1Gi
+  - name: second
+    memory: 2Gi
+`)[0].(map[string]interface{})
+	})
+
+	It("should keep the array only in the default as a whole", func() {
+		merged := mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), deepcopy.Copy(defaultSpec).(map[string]interface{}), map[string]interface{}{}, false)
+		Expect(merged["containers"]).To(Equal(defaultSpec["containers"]))
+	})
+
+	It("should append the unmatched default items to the final map lacking the array", func() {
+		finalMap := map[string]interface{}{}
+		Expect(func() {
+			mergeChangedMap(logr.Discard(), "", "containers", defaultSpec["containers"], []interface{}{}, finalMap, nil, false, nil, 1)
+		}).NotTo(Panic())
+		Expect(finalMap["containers"]).To(Equal(defaultSpec["containers"]))
+	})
+})
+
+var _ = Describe("updateOperandConfig with an empty cpu limit marker", func() {
+	var r *CommonServiceReconciler
+
+	BeforeEach(func() {
+		// The cpu limit of the operator managed by turbo was left as an empty
+		// marker in the OperandConfig
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          cpu: {}
+          ephemeral-storage: 1Gi
+`))
+		cs := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        resources:
+          limits:
+            cpu: "2"
+            ephemeral-storage: 1Gi
+`)
+		cs.Spec.ProfileController = "turbo"
+		r = newTestReconciler(opcon, cs)
+	})
+
+	It("should converge, the marker is the same as the stripped cpu limit", func() {
+		newConfigs := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          ephemeral-storage: 1Gi
+`
+		mapping := map[string]string{"profileController": "turbo"}
+		for i := 0; i < 2; i++ {
+			isEqual, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isEqual).To(BeTrue(), "reconcile %d", i+1)
+		}
+		limits, _, _ := unstructured.NestedMap(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB"), "resources", "limits")
+		Expect(limits).To(Equal(map[string]interface{}{"ephemeral-storage": "1Gi"}))
+	})
+
+	DescribeTable("should compare the empty marker as a missing cpu limit",
+		func(marked, other map[string]interface{}, expected bool) {
+			Expect(rules.ResourceEqualComparison(marked, other)).To(Equal(expected))
+		},
+		Entry("an empty struct against no cpu limit", map[string]interface{}{"cpu": struct{}{}}, map[string]interface{}{}, true),
+		Entry("an empty map against no cpu limit", map[string]interface{}{"cpu": map[string]interface{}{}}, map[string]interface{}{}, true),
+		Entry("an empty struct against a cpu limit", map[string]interface{}{"cpu": struct{}{}}, map[string]interface{}{"cpu": "1"}, false),
+	)
+})
+
+var _ = Describe("getExtremeizes with the smallest value rule", func() {
+	var (
+		ruleSlice []interface{}
+		// The OperandConfig carries the values of tenant-a, as assigned by its merge
+		opconServices = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      connectionPool:
+        minIdle: 10
+`
+	)
+
+	newCR := func(namespace string, replicas, minIdle int) *apiv3.CommonService {
+		return newTestCommonServiceObject(namespace, "example-service", fmt.Sprintf(`
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: %d
+        connectionPool:
+          minIdle: %d
+`, replicas, minIdle))
+	}
+
+	getSpec := func(services []interface{}) map[string]interface{} {
+		return getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+	}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+      connectionPool:
+        minIdle: SMALLEST_VALUE
+`)
+	})
+
+	It("should pick the smaller minIdle under the largest size", func() {
+		r := newTestReconciler(newCR("tenant-a", 1, 10), newCR("tenant-b", 3, 4))
+		services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getSpec(services)["replicas"]).To(BeEquivalentTo(3))
+		Expect(getSpec(services)["connectionPool"].(map[string]interface{})["minIdle"]).To(BeEquivalentTo(4))
+
+		By("growing minIdle back to the smallest value left once tenant-b is deleted")
+		services, err = r.getExtremeizesWithout(context.TODO(), services, ruleSlice, Min, &types.NamespacedName{Namespace: "tenant-b", Name: "example-service"}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getSpec(services)["replicas"]).To(BeEquivalentTo(1))
+		Expect(getSpec(services)["connectionPool"].(map[string]interface{})["minIdle"]).To(BeEquivalentTo(10))
+	})
+
+	DescribeTable("should keep the smaller value of the key in the summary of the CRs",
+		func(defaultMap, changedMap, expected map[string]interface{}) {
+			Expect(mergeCRsIntoOperandConfig(logr.Discard(), defaultMap, changedMap, getRuleForCR(ruleSlice[0], "mongoDB"), false, false, "", nil)).To(Equal(expected))
+		},
+		Entry("with the smaller value in the CR",
+			map[string]interface{}{"replicas": int64(1), "connectionPool": map[string]interface{}{"minIdle": int64(10)}},
+			map[string]interface{}{"replicas": int64(3), "connectionPool": map[string]interface{}{"minIdle": int64(4)}},
+			map[string]interface{}{"replicas": int64(3), "connectionPool": map[string]interface{}{"minIdle": int64(4)}}),
+		Entry("with the smaller value in the summary",
+			map[string]interface{}{"connectionPool": map[string]interface{}{"minIdle": int64(4)}},
+			map[string]interface{}{"connectionPool": map[string]interface{}{"minIdle": int64(10)}},
+			map[string]interface{}{"connectionPool": map[string]interface{}{"minIdle": int64(4)}}),
+	)
+})
@@ -0,0 +1,92 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OpreqRefreshAnnotation is the annotation touched on the OperandRequests of
+// the operators changed in the OperandConfig, the update prompts ODLM to
+// reprocess them
+const OpreqRefreshAnnotation = "operator.ibm.com/operandconfig-refreshed-at"
+
+// refreshOperandRequests annotates the OperandRequests requesting any of the
+// changed operators from the registry of the OperandConfig. It is best effort,
+// ODLM still picks the sizing up on its resync when an OperandRequest fails to
+// be refreshed.
+func (r *CommonServiceReconciler) refreshOperandRequests(ctx context.Context, logger logr.Logger, changedOperators []string) {
+	if !r.Bootstrap.CSData.OpreqRefreshEnable || len(changedOperators) == 0 {
+		return
+	}
+	changed := map[string]bool{}
+	for _, operator := range changedOperators {
+		changed[operator] = true
+	}
+
+	opreqList := &odlm.OperandRequestList{}
+	if err := r.Client.List(ctx, opreqList); err != nil {
+		logger.Error(err, "Failed to list the OperandRequests to refresh")
+		return
+	}
+	refreshedAt := time.Now().UTC().Format(time.RFC3339Nano)
+	for i := range opreqList.Items {
+		opreq := &opreqList.Items[i]
+		operators := r.requestedChangedOperators(opreq, changed)
+		if len(operators) == 0 {
+			continue
+		}
+		original := opreq.DeepCopy()
+		annotations := opreq.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[OpreqRefreshAnnotation] = refreshedAt
+		opreq.SetAnnotations(annotations)
+		if err := r.Client.Patch(ctx, opreq, client.MergeFrom(original)); err != nil {
+			logger.Error(err, "Failed to refresh the OperandRequest", "operandRequest", opreq.Namespace+"/"+opreq.Name)
+			continue
+		}
+		logger.Info("Refreshed the OperandRequest for the changed operators", "operandRequest", opreq.Namespace+"/"+opreq.Name, "operators", operators)
+	}
+}
+
+// requestedChangedOperators returns the changed operators the OperandRequest
+// requests from the registry in the OperandConfig namespace
+func (r *CommonServiceReconciler) requestedChangedOperators(opreq *odlm.OperandRequest, changed map[string]bool) []string {
+	var operators []string
+	for _, request := range opreq.Spec.Requests {
+		registryNs := request.RegistryNamespace
+		if registryNs == "" {
+			registryNs = opreq.Namespace
+		}
+		if registryNs != r.Bootstrap.CSData.ServicesNs {
+			continue
+		}
+		for _, operand := range request.Operands {
+			if changed[operand.Name] {
+				operators = append(operators, operand.Name)
+			}
+		}
+	}
+	return operators
+}
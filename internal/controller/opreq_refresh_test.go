@@ -0,0 +1,98 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func newTestOperandRequest(namespace, name, registryNs string, operands ...string) *odlm.OperandRequest {
+	request := odlm.Request{Registry: "common-service", RegistryNamespace: registryNs}
+	for _, operand := range operands {
+		request.Operands = append(request.Operands, odlm.Operand{Name: operand})
+	}
+	return &odlm.OperandRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       odlm.OperandRequestSpec{Requests: []odlm.Request{request}},
+	}
+}
+
+var _ = Describe("updateOperandConfig refreshing the OperandRequests", func() {
+	var (
+		r          *CommonServiceReconciler
+		mapping    = map[string]string{"profileController": "default"}
+		newConfigs = `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`
+	)
+
+	getRefreshedAt := func(name string) string {
+		opreq := &odlm.OperandRequest{}
+		ExpectWithOffset(1, r.Reader.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "tenant-a"}, opreq)).To(Succeed())
+		return opreq.GetAnnotations()[OpreqRefreshAnnotation]
+	}
+
+	BeforeEach(func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+- name: ibm-other-operator
+  spec:
+    otherCR:
+      replicas: 1
+`))
+		r = newTestReconciler(opcon,
+			newTestOperandRequest("tenant-a", "test-request", testServicesNs, "ibm-test-operator"),
+			newTestOperandRequest("tenant-a", "other-request", testServicesNs, "ibm-other-operator"),
+			newTestOperandRequest("tenant-a", "foreign-request", "other-registry-ns", "ibm-test-operator"),
+		)
+		r.Bootstrap.CSData.OpreqRefreshEnable = true
+	})
+
+	It("should only refresh the OperandRequest of the changed operator from the registry of the OperandConfig", func() {
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		refreshedAt := getRefreshedAt("test-request")
+		Expect(refreshedAt).NotTo(BeEmpty())
+		Expect(getRefreshedAt("other-request")).To(BeEmpty())
+		Expect(getRefreshedAt("foreign-request")).To(BeEmpty())
+
+		By("refreshing nothing on the no-op update")
+		_, err = r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getRefreshedAt("test-request")).To(Equal(refreshedAt))
+		Expect(getRefreshedAt("other-request")).To(BeEmpty())
+	})
+
+	It("should refresh nothing when the mode is off", func() {
+		r.Bootstrap.CSData.OpreqRefreshEnable = false
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getRefreshedAt("test-request")).To(BeEmpty())
+	})
+})
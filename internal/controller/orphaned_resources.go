@@ -0,0 +1,114 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// pruneOrphanedResources removes the resources entries of the OperandConfig
+// services referencing the objects which don't exist in the cluster, either
+// the object or its kind is gone. The entries which can't be checked are kept,
+// including the entries of the kinds the operator isn't allowed to read, see
+// readableResourceKinds. ODLM creates the objects of the requested operands
+// only, so the pruning is opt-in.
+func (r *CommonServiceReconciler) pruneOrphanedResources(ctx context.Context, logger logr.Logger, opconServices []interface{}, opconNs string) []interface{} {
+	for _, opService := range opconServices {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		opResources, ok := opServiceMap["resources"].([]interface{})
+		if !ok {
+			continue
+		}
+		kept := make([]interface{}, 0, len(opResources))
+		for _, opResource := range opResources {
+			if r.isOrphanedResource(ctx, logger, opResource, opconNs) {
+				logger.Info("Pruning the resource, because its object no longer exists", "operator", opServiceMap["name"], "resource", resourceIdentity(opResource, opconNs))
+				continue
+			}
+			kept = append(kept, opResource)
+		}
+		if len(kept) != len(opResources) {
+			opServiceMap["resources"] = kept
+		}
+	}
+	return opconServices
+}
+
+// isOrphanedResource checks whether the object the resource entry references
+// is gone. The namespace defaults to the OperandConfig namespace.
+func (r *CommonServiceReconciler) isOrphanedResource(ctx context.Context, logger logr.Logger, opResource interface{}, opconNs string) bool {
+	opResourceMap, ok := opResource.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	apiVersion, _ := opResourceMap["apiVersion"].(string)
+	kind, _ := opResourceMap["kind"].(string)
+	name, _ := opResourceMap["name"].(string)
+	namespace, _ := opResourceMap["namespace"].(string)
+	if apiVersion == "" || kind == "" || name == "" {
+		return false
+	}
+	if namespace == "" {
+		namespace = opconNs
+	}
+	if !isReadableResourceKind(apiVersion, kind) {
+		logger.V(2).Info("Keeping the resource, because the operator is not allowed to read its kind", "resource", resourceIdentity(opResource, opconNs))
+		return false
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return false
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gv.WithKind(kind))
+	if err := r.Reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+		if errors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return true
+		}
+		// The RBAC of the operator may be narrowed for the namespace
+		if errors.IsForbidden(err) {
+			logger.Info("Keeping the resource, because the operator is forbidden to read its object", "resource", resourceIdentity(opResource, opconNs))
+			return false
+		}
+		logger.Error(err, "Failed to check whether the object of the resource exists, keeping it", "resource", resourceIdentity(opResource, opconNs))
+	}
+	return false
+}
+
+// resourceIdentity returns the apiVersion/kind/namespace/name of the resource
+// entry for the logs
+func resourceIdentity(opResource interface{}, opconNs string) string {
+	opResourceMap, _ := opResource.(map[string]interface{})
+	apiVersion, _ := opResourceMap["apiVersion"].(string)
+	kind, _ := opResourceMap["kind"].(string)
+	name, _ := opResourceMap["name"].(string)
+	namespace, _ := opResourceMap["namespace"].(string)
+	if namespace == "" {
+		namespace = opconNs
+	}
+	return apiVersion + "/" + kind + "/" + namespace + "/" + name
+}
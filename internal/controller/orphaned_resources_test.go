@@ -0,0 +1,137 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("updateOperandConfig pruning the orphaned resources", func() {
+	var (
+		r             *CommonServiceReconciler
+		newConfigs    []interface{}
+		mapping       = map[string]string{"profileController": "default"}
+		opconServices = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: live-config
+    data:
+      data:
+        size: small
+  - apiVersion: v1
+    kind: ConfigMap
+    name: deleted-config
+    namespace: tenant-a
+    data:
+      data:
+        size: small
+`
+	)
+
+	resourceNames := func() []string {
+		var names []string
+		resources, _ := getItemByName(getTestOperandConfig(r, "common-service").Object["spec"].(map[string]interface{})["services"].([]interface{}), "ibm-im-mongodb-operator").(map[string]interface{})["resources"].([]interface{})
+		for _, resource := range resources {
+			names = append(names, resource.(map[string]interface{})["name"].(string))
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		live := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "live-config", Namespace: testServicesNs}}
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(opconServices)), live)
+		newConfigs = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`)
+	})
+
+	It("should keep the resources by default", func() {
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resourceNames()).To(Equal([]string{"live-config", "deleted-config"}))
+	})
+
+	It("should prune the resource of the deleted object, keeping the live one", func() {
+		r.Bootstrap.CSData.PruneOrphanedResources = true
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resourceNames()).To(Equal([]string{"live-config"}))
+	})
+
+	It("should keep the resource of a kind the operator can't read", func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  resources:
+  - apiVersion: v1
+    kind: PersistentVolumeClaim
+    name: deleted-claim
+`)))
+		r.Bootstrap.CSData.PruneOrphanedResources = true
+		c := newHookClient(r)
+		r.Bootstrap.Reader = c
+		c.get = func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name == "deleted-claim" {
+				Fail(fmt.Sprintf("the operator looked up %s", key))
+			}
+			return c.Client.Get(ctx, key, obj)
+		}
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resourceNames()).To(Equal([]string{"deleted-claim"}))
+	})
+
+	It("should keep the resource whose object the operator is forbidden to read", func() {
+		r.Bootstrap.CSData.PruneOrphanedResources = true
+		c := newHookClient(r)
+		r.Bootstrap.Reader = c
+		c.get = func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Namespace == "tenant-a" {
+				return apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, key.Name, fmt.Errorf("no access to the namespace"))
+			}
+			return c.Client.Get(ctx, key, obj)
+		}
+		sink := newRecordingLogSink()
+		r.Log = logr.New(sink)
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resourceNames()).To(Equal([]string{"live-config", "deleted-config"}))
+
+		By("logging the forbidden read instead of a failure")
+		entry := sink.find("forbidden to read its object")
+		Expect(entry).NotTo(BeNil())
+		Expect(entry.values["resource"]).To(Equal("v1/ConfigMap/tenant-a/deleted-config"))
+		Expect(sink.find("Failed to check whether the object of the resource exists")).To(BeNil())
+	})
+})
@@ -0,0 +1,74 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// GetNewConfigsConcurrency is the number of the CommonService CRs rendered at
+// the same time
+const GetNewConfigsConcurrency = 8
+
+// getNewConfigsForCRs renders the configs of the CommonService CRs on a
+// bounded worker pool. The results keep the order of the CRs, so the summary
+// merged from them is stable. A CR failing to render is skipped with a
+// warning, so one broken CR doesn't block the sizing of the others. The CRs
+// rendered are returned along with their results, and the errors are returned
+// only when no CR is rendered.
+func (r *CommonServiceReconciler) getNewConfigsForCRs(logger logr.Logger, csList []unstructured.Unstructured) ([]unstructured.Unstructured, [][]interface{}, []map[string]string, error) {
+	csConfigsList := make([][]interface{}, len(csList))
+	mappingList := make([]map[string]string, len(csList))
+	errs := make([]error, len(csList))
+
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, GetNewConfigsConcurrency)
+	for i := range csList {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-workers }()
+			csConfigsList[i], mappingList[i], errs[i] = r.getNewConfigs(&csList[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var renderedCRs []unstructured.Unstructured
+	var renderedConfigsList [][]interface{}
+	var renderedMappingList []map[string]string
+	var failures []error
+	for i, err := range errs {
+		if err != nil {
+			logger.Info("Skipping the CommonService, because its configs failed to render", "cr", csList[i].GetName(), "namespace", csList[i].GetNamespace(), "error", err)
+			failures = append(failures, fmt.Errorf("CommonService %s/%s: %v", csList[i].GetNamespace(), csList[i].GetName(), err))
+			continue
+		}
+		renderedCRs = append(renderedCRs, csList[i])
+		renderedConfigsList = append(renderedConfigsList, csConfigsList[i])
+		renderedMappingList = append(renderedMappingList, mappingList[i])
+	}
+	if len(renderedCRs) == 0 && len(failures) > 0 {
+		return nil, nil, nil, utilerrors.NewAggregate(failures)
+	}
+	return renderedCRs, renderedConfigsList, renderedMappingList, nil
+}
@@ -0,0 +1,160 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+var _ = Describe("getExtremeizes with many CRs", func() {
+	It("should merge the CRs rendered in parallel as in sequence", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+		opconServices := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 100m
+          memory: 256Mi
+`
+		var objs []client.Object
+		for i := 0; i < 50; i++ {
+			objs = append(objs, newTestCommonServiceObject(fmt.Sprintf("tenant-%d", i), "example-service", fmt.Sprintf(`
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: %d
+        resources:
+          limits:
+            cpu: %dm
+            memory: %dMi
+`, i%5+1, (i*37)%1000+100, (i*53)%2048+256)))
+		}
+		r := newTestReconciler(objs...)
+		// Drop the sizing override events, they would fill the buffered recorder
+		r.Recorder = &record.FakeRecorder{}
+
+		// Render the CRs in sequence as the reference
+		csObjectList := &apiv3.CommonServiceList{}
+		Expect(r.Client.List(context.TODO(), csObjectList)).To(Succeed())
+		csList, err := util.ObjectListToNewUnstructuredList(csObjectList)
+		Expect(err).NotTo(HaveOccurred())
+		var csConfigsList [][]interface{}
+		serviceControllerMappingSummary := make(map[string]string)
+		for i := range csList.Items {
+			csConfigs, serviceControllerMapping, err := r.getNewConfigs(&csList.Items[i])
+			Expect(err).NotTo(HaveOccurred())
+			serviceControllerMappingSummary = mergeProfileController(serviceControllerMappingSummary, serviceControllerMapping)
+			csConfigsList = append(csConfigsList, csConfigs)
+		}
+		expected := mustMergeConfigs(mustConvertStringToSlice(opconServices), csConfigsList, ruleSlice, serviceControllerMappingSummary, Max, testServicesNs)
+
+		services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(Equal(expected))
+	})
+})
+
+// failCommonServiceGets fails to get the CommonService CRs in the namespace
+func failCommonServiceGets(r *CommonServiceReconciler, namespace string) {
+	c := newHookClient(r)
+	c.get = func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+		if _, ok := obj.(*apiv3.CommonService); ok && key.Namespace == namespace {
+			return fmt.Errorf("failed to get CommonService %s", key)
+		}
+		return c.Client.Get(ctx, key, obj)
+	}
+}
+
+var _ = Describe("getExtremeizes with a failing CR", func() {
+	var (
+		ruleSlice     []interface{}
+		tenantB       *apiv3.CommonService
+		opconServices = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`
+	)
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+`)
+		tenantB = newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 5
+`)
+	})
+
+	It("should still merge the other CRs when the largest CR fails to render", func() {
+		tenantA := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 2
+`)
+		tenantC := newTestCommonServiceObject("tenant-c", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		r := newTestReconciler(tenantA, tenantB, tenantC)
+		failCommonServiceGets(r, "tenant-b")
+		services, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(3))
+	})
+
+	It("should fail the merge when no CR renders", func() {
+		r := newTestReconciler(tenantB)
+		failCommonServiceGets(r, "tenant-b")
+		_, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).To(MatchError(ContainSubstring("CommonService tenant-b/example-service")))
+	})
+})
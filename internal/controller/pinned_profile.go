@@ -0,0 +1,147 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/mohae/deepcopy"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PinnedProfileKey is the key of a service in the CommonService CR pinning the
+// operator to a size profile
+const PinnedProfileKey = "profile"
+
+// getPinnedProfiles returns the size profiles pinned by the CommonService CRs
+// for the operators, and the spec set along with the pin, overriding the
+// profile. The pin of the master CR wins, otherwise the first CR pinning the
+// operator wins.
+func (r *CommonServiceReconciler) getPinnedProfiles(csList []unstructured.Unstructured) (map[string]string, map[string]map[string]interface{}) {
+	pinned := map[string]string{}
+	overrides := map[string]map[string]interface{}{}
+	pinnedBy := map[string]string{}
+	logger := r.mergeLogger()
+	for _, cs := range csList {
+		key := cs.GetNamespace() + "/" + cs.GetName()
+		isMaster := r.checkNamespace(key)
+		services, _, _ := unstructured.NestedSlice(cs.Object, "spec", "services")
+		for _, service := range services {
+			serviceMap, ok := service.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := serviceMap["name"].(string)
+			profile, _ := serviceMap[PinnedProfileKey].(string)
+			if name == "" || profile == "" {
+				continue
+			}
+			if existing, ok := pinned[name]; ok {
+				if existing == profile {
+					continue
+				}
+				if !isMaster {
+					logger.Info("Ignoring the pinned profile, the operator is pinned to another profile by another CommonService", "operator", name, "cr", cs.GetName(), "namespace", cs.GetNamespace(), "profile", profile, "pinnedProfile", existing, "pinnedBy", pinnedBy[name])
+					continue
+				}
+				logger.Info("The profile pinned by the master CommonService overrides the profile pinned by another CommonService", "operator", name, "cr", cs.GetName(), "namespace", cs.GetNamespace(), "profile", profile, "overriddenProfile", existing, "overriddenBy", pinnedBy[name])
+			}
+			pinned[name] = profile
+			pinnedBy[name] = key
+			if spec, ok := serviceMap["spec"].(map[string]interface{}); ok {
+				overrides[name] = deepcopy.Copy(spec).(map[string]interface{})
+			} else {
+				delete(overrides, name)
+			}
+		}
+	}
+	return pinned, overrides
+}
+
+// expandPinnedProfiles expands the pinned operators from the size profile
+// catalog, sorted by the operator name, then assigns the overrides of the
+// operators on top of the profile, e.g. "medium" with a larger memory. The
+// operators missing from the catalog are skipped.
+func expandPinnedProfiles(logger logr.Logger, pinned map[string]string, overrides map[string]map[string]interface{}) ([]interface{}, error) {
+	operators := make([]string, 0, len(pinned))
+	for operator := range pinned {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	catalogs := map[string][]interface{}{}
+	var configs []interface{}
+	for _, operator := range operators {
+		profile := pinned[operator]
+		catalog, ok := catalogs[profile]
+		if !ok {
+			sizeTemplate, ok := getSizeTemplate(profile)
+			if !ok {
+				return nil, fmt.Errorf("unknown profile %s pinned for operator %s", profile, operator)
+			}
+			var err error
+			if catalog, err = convertStringToSlice(sizeTemplate); err != nil {
+				return nil, err
+			}
+			catalogs[profile] = catalog
+		}
+		config := getItemByName(catalog, operator)
+		if config == nil {
+			logger.Info("Skipping the pinned profile, because the operator is not in the profile", "operator", operator, "profile", profile)
+			continue
+		}
+		logger.V(2).Info("Expanding the pinned profile", "operator", operator, "profile", profile)
+		config = deepcopy.Copy(config)
+		overridePinnedProfile(logger, operator, config, overrides[operator])
+		configs = append(configs, config)
+	}
+	return configs, nil
+}
+
+// overridePinnedProfile assigns the spec of the CR pinning the profile over the
+// spec expanded from the profile, the keys the CR doesn't set keep the values
+// of the profile
+func overridePinnedProfile(logger logr.Logger, operator string, config interface{}, override map[string]interface{}) {
+	if len(override) == 0 {
+		return
+	}
+	configMap, ok := config.(map[string]interface{})
+	if !ok {
+		return
+	}
+	spec, ok := configMap["spec"].(map[string]interface{})
+	if !ok {
+		spec = map[string]interface{}{}
+		configMap["spec"] = spec
+	}
+	for cr, overrideSpec := range override {
+		overrideSpecMap, ok := overrideSpec.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		logger.V(2).Info("Overriding the pinned profile", "operator", operator, "cr", cr)
+		overrideSpecMap = deepcopy.Copy(overrideSpecMap).(map[string]interface{})
+		profileSpec, ok := spec[cr].(map[string]interface{})
+		if !ok {
+			spec[cr] = overrideSpecMap
+			continue
+		}
+		spec[cr] = mergeCRsIntoOperandConfigWithDefaultRules(logger, profileSpec, overrideSpecMap, true)
+	}
+}
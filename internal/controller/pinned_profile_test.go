@@ -0,0 +1,162 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/size"
+)
+
+var _ = Describe("updateOperandConfig with a pinned profile", func() {
+	It("should size the operator by the pinned profile", func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 4000m
+          memory: 4Gi
+`))
+		// The larger sizing of the other CR doesn't nudge the pinned operator
+		pinning := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    profile: medium
+`)
+		other := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 5
+        resources:
+          limits:
+            cpu: 3000m
+`)
+		r := newTestReconciler(opcon, pinning, other)
+		_, err := r.updateOperandConfig(context.TODO(), nil, map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+
+		catalog := mustConvertStringToSlice(size.Medium)
+		expected := getItemByName(catalog, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+		mongoDB := getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")
+		Expect(mongoDB["replicas"]).To(BeEquivalentTo(expected["replicas"]))
+		for _, key := range []string{"cpu", "memory"} {
+			expectedValue, _, _ := unstructured.NestedFieldNoCopy(expected, "resources", "limits", key)
+			value, _, _ := unstructured.NestedFieldNoCopy(mongoDB, "resources", "limits", key)
+			Expect(value).To(Equal(expectedValue), key)
+		}
+
+	})
+
+	It("should let the master CR override the profile pinned by the other CRs", func() {
+		pinning := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    profile: medium
+`)
+		master := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    profile: small
+`)
+		var csList []unstructured.Unstructured
+		for _, cs := range []*apiv3.CommonService{pinning, master} {
+			contents, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cs)
+			Expect(err).NotTo(HaveOccurred())
+			csList = append(csList, unstructured.Unstructured{Object: contents})
+		}
+		pinned, _ := newTestReconciler().getPinnedProfiles(csList)
+		Expect(pinned).To(Equal(map[string]string{"ibm-im-mongodb-operator": "small"}))
+	})
+
+	It("should reject an unknown profile", func() {
+		_, err := expandPinnedProfiles(logr.Discard(), map[string]string{"ibm-im-mongodb-operator": "huge"}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("expandPinnedProfiles with an override", func() {
+	var (
+		override map[string]interface{}
+		expected map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		override = mustConvertStringToSlice(`
+- mongoDB:
+    resources:
+      limits:
+        memory: 12Gi
+`)[0].(map[string]interface{})
+		catalog := mustConvertStringToSlice(size.Medium)
+		expected = getItemByName(catalog, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+	})
+
+	It("should only override the keys set by the CR", func() {
+		configs, err := expandPinnedProfiles(logr.Discard(), map[string]string{"ibm-im-mongodb-operator": "medium"}, map[string]map[string]interface{}{"ibm-im-mongodb-operator": override})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configs).To(HaveLen(1))
+
+		mongoDB := configs[0].(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+		// The memory takes the override, the cpu and the replicas stay at the profile
+		memory, _, _ := unstructured.NestedString(mongoDB, "resources", "limits", "memory")
+		Expect(memory).To(Equal("12Gi"))
+		expectedCPU, _, _ := unstructured.NestedFieldNoCopy(expected, "resources", "limits", "cpu")
+		cpu, _, _ := unstructured.NestedFieldNoCopy(mongoDB, "resources", "limits", "cpu")
+		Expect(cpu).NotTo(BeNil())
+		Expect(cpu).To(Equal(expectedCPU))
+		Expect(mongoDB["replicas"]).To(Equal(expected["replicas"]))
+		expectedRequests, _, _ := unstructured.NestedFieldNoCopy(expected, "resources", "requests")
+		requests, _, _ := unstructured.NestedFieldNoCopy(mongoDB, "resources", "requests")
+		Expect(requests).To(Equal(expectedRequests))
+
+		By("leaving the catalog untouched")
+		expanded, err := expandPinnedProfiles(logr.Discard(), map[string]string{"ibm-im-mongodb-operator": "medium"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expanded[0].(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"]).To(Equal(expected))
+	})
+
+	It("should keep the override from the CR pinning the profile", func() {
+		pinning := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    profile: medium
+    spec:
+      mongoDB:
+        resources:
+          limits:
+            memory: 12Gi
+`)
+		contents, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pinning)
+		Expect(err).NotTo(HaveOccurred())
+		pinned, overrides := newTestReconciler().getPinnedProfiles([]unstructured.Unstructured{{Object: contents}})
+		Expect(pinned).To(Equal(map[string]string{"ibm-im-mongodb-operator": "medium"}))
+		memory, _, _ := unstructured.NestedString(overrides["ibm-im-mongodb-operator"], "mongoDB", "resources", "limits", "memory")
+		Expect(memory).To(Equal("12Gi"))
+	})
+})
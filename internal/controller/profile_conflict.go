@@ -0,0 +1,122 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+)
+
+// ProfileControllerConflictReason is the reason of the events recorded on the
+// CommonService CRs assigning an operator to conflicting profile controllers
+const ProfileControllerConflictReason = "ProfileControllerConflict"
+
+// profileControllerConflicts keeps the conflicts found for each CommonService
+// CR by the last merge, they are set in the status of the CR it reconciles
+var profileControllerConflicts sync.Map
+
+// profileControllerConflict is an operator assigned to different non-default
+// profile controllers by the CommonService CRs
+type profileControllerConflict struct {
+	Operator    string
+	Controllers []string
+	CRs         []string
+}
+
+func (c profileControllerConflict) String() string {
+	return fmt.Sprintf("operator %s is assigned to the profile controllers %s by the CommonService CRs %s", c.Operator, strings.Join(c.Controllers, ", "), strings.Join(c.CRs, ", "))
+}
+
+// findProfileControllerConflicts returns the operators which the CRs assign to
+// more than one non-default profile controller, sorted by the operator name
+func findProfileControllerConflicts(csList []unstructured.Unstructured, mappingList []map[string]string) []profileControllerConflict {
+	controllersByOperator := map[string]map[string][]string{}
+	for i, mapping := range mappingList {
+		for operator, controller := range mapping {
+			if !isNonDefaultProfileController(controller) {
+				continue
+			}
+			if controllersByOperator[operator] == nil {
+				controllersByOperator[operator] = map[string][]string{}
+			}
+			controllersByOperator[operator][controller] = append(controllersByOperator[operator][controller], csList[i].GetNamespace()+"/"+csList[i].GetName())
+		}
+	}
+
+	var conflicts []profileControllerConflict
+	for operator, crsByController := range controllersByOperator {
+		if len(crsByController) < 2 {
+			continue
+		}
+		conflict := profileControllerConflict{Operator: operator}
+		for controller, crs := range crsByController {
+			conflict.Controllers = append(conflict.Controllers, controller)
+			conflict.CRs = append(conflict.CRs, crs...)
+		}
+		sort.Strings(conflict.Controllers)
+		sort.Strings(conflict.CRs)
+		conflicts = append(conflicts, conflict)
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Operator < conflicts[j].Operator
+	})
+	return conflicts
+}
+
+// reportProfileControllerConflicts warns about the conflicting profile
+// controllers on the CommonService CRs assigning them, and keeps the conflicts
+// of each CR for its status
+func (r *CommonServiceReconciler) reportProfileControllerConflicts(csList []unstructured.Unstructured, mappingList []map[string]string) {
+	conflictsByCR := map[string][]string{}
+	for _, conflict := range findProfileControllerConflicts(csList, mappingList) {
+		klog.Warningf("Conflicting profile controllers: %s", conflict.String())
+		for _, cr := range conflict.CRs {
+			conflictsByCR[cr] = append(conflictsByCR[cr], conflict.String())
+		}
+	}
+
+	for i := range csList {
+		cs := &csList[i]
+		key := types.NamespacedName{Namespace: cs.GetNamespace(), Name: cs.GetName()}
+		conflicts, ok := conflictsByCR[key.String()]
+		if !ok {
+			profileControllerConflicts.Delete(key)
+			continue
+		}
+		profileControllerConflicts.Store(key, conflicts)
+		for _, conflict := range conflicts {
+			r.Recorder.Event(cs, corev1.EventTypeWarning, ProfileControllerConflictReason, "The "+conflict)
+		}
+	}
+}
+
+// getProfileControllerConflicts returns the conflicts found for the
+// CommonService CR by the last merge
+func getProfileControllerConflicts(key types.NamespacedName) []string {
+	conflicts, ok := profileControllerConflicts.Load(key)
+	if !ok {
+		return nil
+	}
+	return conflicts.([]string)
+}
@@ -0,0 +1,122 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("Profile controller conflicts", func() {
+	var (
+		opcon    *unstructured.Unstructured
+		turbo    *apiv3.CommonService
+		vpa      *apiv3.CommonService
+		instance *apiv3.CommonService
+	)
+	newConfigs := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`
+	mapping := map[string]string{"profileController": "default", "ibm-im-mongodb-operator": "turbo"}
+	expected := "operator ibm-im-mongodb-operator is assigned to the profile controllers turbo, vpa by the CommonService CRs tenant-a/example-service, tenant-b/example-service"
+	getCondition := func() *apiv3.CommonServiceCondition {
+		for i := range instance.Status.Conditions {
+			if instance.Status.Conditions[i].Type == apiv3.ConditionTypeProfileControllerConflict {
+				return &instance.Status.Conditions[i]
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		opcon = newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`))
+		turbo = newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    managementStrategy: turbo
+    spec:
+      mongoDB:
+        replicas: 1
+`)
+		vpa = newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    managementStrategy: vpa
+    spec:
+      mongoDB:
+        replicas: 1
+`)
+		instance = turbo.DeepCopy()
+	})
+
+	AfterEach(func() {
+		profileControllerConflicts.Delete(types.NamespacedName{Namespace: "tenant-a", Name: "example-service"})
+		profileControllerConflicts.Delete(types.NamespacedName{Namespace: "tenant-b", Name: "example-service"})
+	})
+
+	It("should report the conflict on both CRs", func() {
+		r := newTestReconciler(opcon, turbo, vpa)
+		recorder := record.NewFakeRecorder(100)
+		r.Recorder = recorder
+		_, err := r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		condition := getCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(apiv3.ConditionReasonConflict))
+		Expect(condition.Message).To(Equal(expected))
+		Expect(getProfileControllerConflicts(types.NamespacedName{Namespace: "tenant-b", Name: "example-service"})).To(Equal([]string{expected}))
+
+		close(recorder.Events)
+		var events []string
+		for event := range recorder.Events {
+			if strings.Contains(event, ProfileControllerConflictReason) {
+				events = append(events, event)
+			}
+		}
+		Expect(events).To(Equal([]string{"Warning ProfileControllerConflict The " + expected, "Warning ProfileControllerConflict The " + expected}))
+	})
+
+	It("should remove the condition once the conflict is resolved", func() {
+		r := newTestReconciler(opcon.DeepCopy(), turbo.DeepCopy(), vpa)
+		_, err := r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getCondition()).NotTo(BeNil())
+
+		r = newTestReconciler(opcon, turbo)
+		_, err = r.updateOperandConfigWithCondition(context.TODO(), instance, mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getCondition()).To(BeNil())
+	})
+})
@@ -0,0 +1,212 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/klog"
+)
+
+var (
+	nonDefaultProfileControllerLock sync.RWMutex
+	// nonDefaultProfileController are the independent profile controllers,
+	// which have higher priority than the default CS controller, mapped to
+	// their priority among each other. The controller with the larger priority
+	// wins an operator assigned to several controllers.
+	nonDefaultProfileController = map[string]int{
+		"turbo":      0,
+		"turbonomic": 0,
+		"vpa":        1,
+	}
+	// profileResetController are the non-default profile controllers which
+	// want the profile cleaned up along with the sizing
+	profileResetController = map[string]bool{}
+	// defaultProfileControllerResetKeys are the sizing keys cleaned up for the
+	// non-default profile controllers without their own reset keys
+	defaultProfileControllerResetKeys = []string{"replicas", "cpu", "memory"}
+	// profileControllerResetKeys are the sizing keys managed by each
+	// non-default profile controller, they are cleaned up from the merged
+	// sizing. vpa scales the cpu and memory but leaves the replicas to the CS
+	// operator.
+	profileControllerResetKeys = map[string][]string{
+		"vpa": {"cpu", "memory"},
+	}
+
+	lastProfileControllerMappingLock sync.Mutex
+	// lastProfileControllerMapping is the effective profile controller mapping
+	// of the last reconcile, kept to log how it changes
+	lastProfileControllerMapping map[string]string
+)
+
+// profileControllerMappingDiff is the difference between two profile
+// controller mappings
+type profileControllerMappingDiff struct {
+	// Added are the operators assigned to a profile controller
+	Added map[string]string
+	// Removed are the operators no longer assigned to a profile controller
+	Removed map[string]string
+	// Changed are the operators switching the profile controller, mapped to
+	// the old and the new profile controller
+	Changed map[string][2]string
+}
+
+func (d profileControllerMappingDiff) isEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// diffProfileControllerMappings returns the operator to profile controller
+// entries added, removed and changed from the old mapping to the new one
+func diffProfileControllerMappings(oldMapping, newMapping map[string]string) profileControllerMappingDiff {
+	diff := profileControllerMappingDiff{
+		Added:   map[string]string{},
+		Removed: map[string]string{},
+		Changed: map[string][2]string{},
+	}
+	for operator, controller := range newMapping {
+		oldController, ok := oldMapping[operator]
+		if !ok {
+			diff.Added[operator] = controller
+		} else if oldController != controller {
+			diff.Changed[operator] = [2]string{oldController, controller}
+		}
+	}
+	for operator, controller := range oldMapping {
+		if _, ok := newMapping[operator]; !ok {
+			diff.Removed[operator] = controller
+		}
+	}
+	return diff
+}
+
+// logProfileControllerMappingChange logs how the effective profile controller
+// mapping changed since the last reconcile
+func logProfileControllerMappingChange(logger logr.Logger, mapping map[string]string) {
+	lastProfileControllerMappingLock.Lock()
+	defer lastProfileControllerMappingLock.Unlock()
+	diff := diffProfileControllerMappings(lastProfileControllerMapping, mapping)
+	if diff.isEmpty() {
+		return
+	}
+	logger.Info("The effective profile controller mapping changed", "added", diff.Added, "removed", diff.Removed, "changed", diff.Changed)
+	lastProfileControllerMapping = make(map[string]string, len(mapping))
+	for operator, controller := range mapping {
+		lastProfileControllerMapping[operator] = controller
+	}
+}
+
+// RegisterNonDefaultProfileControllers registers the independent profile
+// controllers in addition to the built-in ones
+func RegisterNonDefaultProfileControllers(controllers ...string) {
+	nonDefaultProfileControllerLock.Lock()
+	defer nonDefaultProfileControllerLock.Unlock()
+	for _, controller := range controllers {
+		if _, ok := nonDefaultProfileController[controller]; ok {
+			continue
+		}
+		klog.Infof("Registering non-default profile controller %s", controller)
+		nonDefaultProfileController[controller] = 0
+	}
+}
+
+func isNonDefaultProfileController(controller string) bool {
+	nonDefaultProfileControllerLock.RLock()
+	defer nonDefaultProfileControllerLock.RUnlock()
+	_, ok := nonDefaultProfileController[controller]
+	return ok
+}
+
+// profileControllerPriority returns the priority of the independent profile
+// controller, it is false for the default CS controller
+func profileControllerPriority(controller string) (int, bool) {
+	nonDefaultProfileControllerLock.RLock()
+	defer nonDefaultProfileControllerLock.RUnlock()
+	priority, ok := nonDefaultProfileController[controller]
+	return priority, ok
+}
+
+// RegisterProfileResetControllers registers the non-default profile
+// controllers which want the profile cleaned up along with the sizing, the
+// others keep the profile
+func RegisterProfileResetControllers(controllers ...string) {
+	nonDefaultProfileControllerLock.Lock()
+	defer nonDefaultProfileControllerLock.Unlock()
+	for _, controller := range controllers {
+		klog.Infof("Registering profile controller %s to reset the profile", controller)
+		profileResetController[controller] = true
+	}
+}
+
+// RegisterProfileControllerResetKeys registers the sizing keys managed by the
+// non-default profile controller, e.g. replicas for a horizontal autoscaler,
+// replacing the default ones
+func RegisterProfileControllerResetKeys(controller string, keys ...string) {
+	nonDefaultProfileControllerLock.Lock()
+	defer nonDefaultProfileControllerLock.Unlock()
+	klog.Infof("Registering profile controller %s to reset the keys %v", controller, keys)
+	profileControllerResetKeys[controller] = append([]string(nil), keys...)
+}
+
+// getProfileControllerResetKeys returns the keys cleaned up from the sizing of
+// the operators managed by the profile controller, the profile is included
+// for the profile reset controllers
+func getProfileControllerResetKeys(controller string) map[string]bool {
+	nonDefaultProfileControllerLock.RLock()
+	defer nonDefaultProfileControllerLock.RUnlock()
+	keys, ok := profileControllerResetKeys[controller]
+	if !ok {
+		keys = defaultProfileControllerResetKeys
+	}
+	resetKeys := make(map[string]bool, len(keys)+1)
+	for _, key := range keys {
+		resetKeys[key] = true
+	}
+	if profileResetController[controller] {
+		resetKeys["profile"] = true
+	}
+	return resetKeys
+}
+
+// EffectiveProfileController returns the profile controller assigned to the
+// operator by the summary of all the CommonService CRs, an independent profile
+// controller wins over the default one. The operator not assigned by any CR
+// falls back to the profile controller of the CRs.
+func (r *CommonServiceReconciler) EffectiveProfileController(ctx context.Context, operatorName string) (string, error) {
+	logger := r.mergeLogger()
+	activeCRs, err := r.listActiveCommonServices(ctx, logger)
+	if err != nil {
+		return "", err
+	}
+	_, _, mappingList, err := r.getNewConfigsForCRs(logger, activeCRs)
+	if err != nil {
+		return "", err
+	}
+
+	serviceControllerMappingSummary := make(map[string]string)
+	for _, mapping := range mappingList {
+		serviceControllerMappingSummary = mergeProfileController(serviceControllerMappingSummary, mapping)
+	}
+	if controller, ok := serviceControllerMappingSummary[operatorName]; ok {
+		return controller, nil
+	}
+	if controller, ok := serviceControllerMappingSummary["profileController"]; ok {
+		return controller, nil
+	}
+	return "default", nil
+}
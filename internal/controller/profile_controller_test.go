@@ -0,0 +1,300 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("RegisterNonDefaultProfileControllers", func() {
+	opconServices := `
+- name: ibm-test-operator
+  spec:
+    testCR: {}
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            cpu: 100m
+`
+	newConfigs := `
+- name: ibm-test-operator
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            cpu: 200m
+`
+	mapping := map[string]string{"profileController": "default", "ibm-test-operator": "keda"}
+	getCPULimit := func(services []interface{}) interface{} {
+		resources := getItemByName(services, "ibm-test-operator").(map[string]interface{})["resources"].([]interface{})
+		cpu, _, _ := unstructured.NestedFieldNoCopy(resources[0].(map[string]interface{}), "data", "spec", "resources", "limits", "cpu")
+		return cpu
+	}
+
+	AfterEach(func() {
+		nonDefaultProfileControllerLock.Lock()
+		delete(nonDefaultProfileController, "keda")
+		nonDefaultProfileControllerLock.Unlock()
+	})
+
+	It("should merge the cpu limit of an unregistered profile controller", func() {
+		services := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices), mustConvertStringToSlice(newConfigs), nil, mapping, testServicesNs, 1)
+		Expect(getCPULimit(services)).To(Equal("200m"))
+	})
+
+	It("should clean up the cpu limit of the operator mapped to a registered profile controller", func() {
+		RegisterNonDefaultProfileControllers("keda")
+		Expect(isNonDefaultProfileController("keda")).To(BeTrue())
+		Expect(isNonDefaultProfileController("turbo")).To(BeTrue())
+		services := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(opconServices), mustConvertStringToSlice(newConfigs), nil, mapping, testServicesNs, 1)
+		Expect(getCPULimit(services)).To(BeNil())
+	})
+
+	It("should let the registered profile controller win over the default one in the mapping summary", func() {
+		RegisterNonDefaultProfileControllers("keda")
+		summary := mergeProfileController(map[string]string{"ibm-test-operator": "default"}, map[string]string{"ibm-test-operator": "keda"})
+		Expect(summary["ibm-test-operator"]).To(Equal("keda"))
+	})
+})
+
+var _ = Describe("resetResourceInTemplate", func() {
+	spec := `
+- profile: large
+  replicas: 3
+  resources:
+    limits:
+      cpu: 1000m
+`
+	var rules interface{}
+
+	BeforeEach(func() {
+		rules = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      profile: LARGEST_VALUE
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+`)[0]
+		RegisterProfileResetControllers("vpa")
+	})
+
+	AfterEach(func() {
+		nonDefaultProfileControllerLock.Lock()
+		delete(profileResetController, "vpa")
+		nonDefaultProfileControllerLock.Unlock()
+	})
+
+	DescribeTable("should reset the resources managed by the profile controller",
+		func(controller string, expected map[string]interface{}) {
+			specMap := mustConvertStringToSlice(spec)[0].(map[string]interface{})
+			Expect(resetResourceInTemplate(logr.Discard(), specMap, "testCR", rules, controller)).To(Equal(expected))
+		},
+		Entry("keep profile", "turbo", map[string]interface{}{"profile": "large", "resources": map[string]interface{}{"limits": map[string]interface{}{}}}),
+		// vpa leaves the replicas to the CS operator
+		Entry("clear profile", "vpa", map[string]interface{}{"replicas": float64(3), "resources": map[string]interface{}{"limits": map[string]interface{}{}}}),
+	)
+})
+
+var _ = Describe("EffectiveProfileController", func() {
+	var tenantA, tenantB *apiv3.CommonService
+
+	BeforeEach(func() {
+		tenantA = newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    managementStrategy: default
+    spec:
+      mongoDB:
+        replicas: 1
+  - name: ibm-test-operator
+    managementStrategy: vpa
+`)
+		tenantB = newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    managementStrategy: turbo
+  - name: ibm-test-operator
+    managementStrategy: default
+`)
+	})
+
+	DescribeTable("should let the independent profile controller win over the default one, whatever the order of the CRs",
+		func(operator, expected string) {
+			controller, err := newTestReconciler(tenantA, tenantB).EffectiveProfileController(context.TODO(), operator)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(controller).To(Equal(expected))
+		},
+		Entry("assigned by the second CR", "ibm-im-mongodb-operator", "turbo"),
+		Entry("assigned by the first CR", "ibm-test-operator", "vpa"),
+	)
+
+	It("should take the profile controller of the CRs for the operator not assigned by any CR", func() {
+		controller, err := newTestReconciler(tenantA, tenantB).EffectiveProfileController(context.TODO(), "ibm-events-operator")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(controller).To(Equal("default"))
+
+		tenantC := newTestCommonServiceObject("tenant-c", "example-service", `
+- profileController: turbo
+`)
+		controller, err = newTestReconciler(tenantA, tenantB, tenantC).EffectiveProfileController(context.TODO(), "ibm-events-operator")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(controller).To(Equal("turbo"))
+	})
+})
+
+var _ = Describe("mergeProfileController", func() {
+	mappings := []map[string]string{
+		{"ibm-test-operator": "default", "ibm-other-operator": "turbo"},
+		{"ibm-test-operator": "turbo", "ibm-other-operator": "turbonomic"},
+		{"ibm-test-operator": "vpa"},
+	}
+
+	// The CRs are merged in every order, vpa wins over turbo by its priority
+	DescribeTable("should pick the controller of the highest priority",
+		func(order []int) {
+			summary := map[string]string{}
+			for _, i := range order {
+				summary = mergeProfileController(summary, mappings[i])
+			}
+			Expect(summary["ibm-test-operator"]).To(Equal("vpa"))
+		},
+		Entry("in the order 0, 1, 2", []int{0, 1, 2}),
+		Entry("in the order 0, 2, 1", []int{0, 2, 1}),
+		Entry("in the order 1, 0, 2", []int{1, 0, 2}),
+		Entry("in the order 1, 2, 0", []int{1, 2, 0}),
+		Entry("in the order 2, 0, 1", []int{2, 0, 1}),
+		Entry("in the order 2, 1, 0", []int{2, 1, 0}),
+	)
+
+	It("should keep the controller already in the summary on a tie", func() {
+		summary := mergeProfileController(map[string]string{}, mappings[0])
+		summary = mergeProfileController(summary, mappings[1])
+		Expect(summary["ibm-other-operator"]).To(Equal("turbo"))
+	})
+})
+
+var _ = Describe("diffProfileControllerMappings", func() {
+	oldMapping := map[string]string{
+		"ibm-test-operator":    "default",
+		"ibm-removed-operator": "turbo",
+		"ibm-same-operator":    "turbo",
+	}
+	newMapping := map[string]string{
+		"ibm-test-operator":  "vpa",
+		"ibm-added-operator": "turbo",
+		"ibm-same-operator":  "turbo",
+	}
+
+	It("should list the added, removed and changed controllers", func() {
+		diff := diffProfileControllerMappings(oldMapping, newMapping)
+		Expect(diff.Added).To(Equal(map[string]string{"ibm-added-operator": "turbo"}))
+		Expect(diff.Removed).To(Equal(map[string]string{"ibm-removed-operator": "turbo"}))
+		Expect(diff.Changed).To(Equal(map[string][2]string{"ibm-test-operator": {"default", "vpa"}}))
+	})
+
+	It("should be empty for the same mappings", func() {
+		Expect(diffProfileControllerMappings(newMapping, newMapping).isEmpty()).To(BeTrue())
+	})
+
+	It("should add every controller to an empty mapping", func() {
+		Expect(diffProfileControllerMappings(nil, newMapping).Added).To(Equal(newMapping))
+	})
+})
+
+var _ = Describe("resetResourceInTemplate by the reset keys of the controller", func() {
+	var rules interface{}
+	spec := `
+- replicas: 3
+  resources:
+    limits:
+      cpu: 1000m
+      memory: 1Gi
+`
+
+	BeforeEach(func() {
+		rules = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)[0]
+		RegisterNonDefaultProfileControllers("replica-scaler")
+		RegisterProfileControllerResetKeys("replica-scaler", "replicas")
+	})
+
+	AfterEach(func() {
+		nonDefaultProfileControllerLock.Lock()
+		delete(nonDefaultProfileController, "replica-scaler")
+		delete(profileControllerResetKeys, "replica-scaler")
+		nonDefaultProfileControllerLock.Unlock()
+	})
+
+	DescribeTable("should reset the keys managed by the controller",
+		func(controller string, expected map[string]interface{}) {
+			specMap := mustConvertStringToSlice(spec)[0].(map[string]interface{})
+			Expect(resetResourceInTemplate(logr.Discard(), specMap, "testCR", rules, controller)).To(Equal(expected))
+		},
+		Entry("vpa resets cpu and memory", "vpa", map[string]interface{}{"replicas": float64(3), "resources": map[string]interface{}{"limits": map[string]interface{}{}}}),
+		Entry("replica controller resets replicas", "replica-scaler", map[string]interface{}{"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "1000m", "memory": "1Gi"}}}),
+		Entry("turbo resets all", "turbo", map[string]interface{}{"resources": map[string]interface{}{"limits": map[string]interface{}{}}}),
+	)
+
+	It("should keep the cpu limit of the resources for the replica controller", func() {
+		resource := mustConvertStringToSlice(`
+- apiVersion: apps/v1
+  kind: Deployment
+  name: test-deployment
+  data:
+    spec:
+      resources:
+        limits:
+          cpu: 100m
+`)[0]
+		stripCPULimit(logr.Discard(), resource, "ibm-test-operator", "replica-scaler")
+		limits, _, _ := unstructured.NestedMap(resource.(map[string]interface{}), "data", "spec", "resources", "limits")
+		Expect(limits).To(HaveKeyWithValue("cpu", "100m"))
+
+		By("stripping the cpu limit for vpa")
+		stripCPULimit(logr.Discard(), resource, "ibm-test-operator", "vpa")
+		limits, _, _ = unstructured.NestedMap(resource.(map[string]interface{}), "data", "spec", "resources", "limits")
+		Expect(limits).NotTo(HaveKey("cpu"))
+	})
+})
@@ -36,14 +36,34 @@ import (
 )
 
 func (r *CommonServiceReconciler) getNewConfigs(cs *unstructured.Unstructured) ([]interface{}, map[string]string, error) {
-	var newConfigs []interface{}
-	var err error
-
 	csObject := &apiv3.CommonService{}
 	if err := r.Client.Get(context.TODO(), types.NamespacedName{Name: cs.GetName(), Namespace: cs.GetNamespace()}, csObject); err != nil {
 		return nil, nil, err
 	}
 
+	ruleSlice, err := getConfigurationRules()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newConfigs, serviceControllerMapping, err := r.buildNewConfigs(cs, csObject, ruleSlice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newConfigs, err = r.resolveResourceSelectors(context.TODO(), newConfigs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return newConfigs, serviceControllerMapping, nil
+}
+
+// buildNewConfigs renders the configs and the profile controller mapping from
+// the CommonService CR, it doesn't reach the cluster
+func (r *CommonServiceReconciler) buildNewConfigs(cs *unstructured.Unstructured, csObject *apiv3.CommonService, ruleSlice []interface{}) ([]interface{}, map[string]string, error) {
+	var newConfigs []interface{}
+	var err error
+
 	// Update storageclass in OperandConfig
 	if cs.Object["spec"].(map[string]interface{})["storageClass"] != nil {
 		klog.Info("Applying storageClass configuration")
@@ -190,42 +210,57 @@ func (r *CommonServiceReconciler) getNewConfigs(cs *unstructured.Unstructured) (
 		serviceControllerMapping["profileController"] = controller.(string)
 	}
 
-	switch cs.Object["spec"].(map[string]interface{})["size"] {
-	case "starterset", "starter":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.StarterSet, serviceControllerMapping, r.CSData.ServicesNs)
-		if err != nil {
-			return sizeConfigs, serviceControllerMapping, err
-		}
-	case "small":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.Small, serviceControllerMapping, r.CSData.ServicesNs)
-		if err != nil {
-			return sizeConfigs, serviceControllerMapping, err
-		}
-	case "medium":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.Medium, serviceControllerMapping, r.CSData.ServicesNs)
-		if err != nil {
-			return sizeConfigs, serviceControllerMapping, err
-		}
-	case "large", "production":
-		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, size.Large, serviceControllerMapping, r.CSData.ServicesNs)
+	// The well-known keys of the services are validated before they are merged
+	sizeSpecs, err := decodeSizeSpec(cs.Object["spec"].(map[string]interface{})["services"])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sizeName, _ := cs.Object["spec"].(map[string]interface{})["size"].(string)
+	if sizeTemplate, ok := getSizeTemplate(sizeName); ok {
+		sizeConfigs, serviceControllerMapping, err = applySizeTemplate(cs, sizeTemplate, serviceControllerMapping, r.CSData.ServicesNs)
 		if err != nil {
 			return sizeConfigs, serviceControllerMapping, err
 		}
-	default:
-		sizeConfigs, serviceControllerMapping = applySizeConfigs(cs, serviceControllerMapping)
+	} else {
+		sizeConfigs, serviceControllerMapping = applySizeConfigs(cs, sizeSpecs, serviceControllerMapping)
 	}
 	newConfigs = append(newConfigs, sizeConfigs...)
 
+	// Sizing hints from the annotation are merged after the size configuration
+	hintConfigs, err := getSizingHints(cs, ruleSlice)
+	if err != nil {
+		return nil, nil, err
+	}
+	newConfigs = append(newConfigs, hintConfigs...)
+
 	return newConfigs, serviceControllerMapping, nil
 }
 
-func applySizeConfigs(cs *unstructured.Unstructured, serviceControllerMapping map[string]string) ([]interface{}, map[string]string) {
+// getSizeTemplate returns the size profile catalog of the size name
+func getSizeTemplate(sizeName string) (string, bool) {
+	switch sizeName {
+	case "starterset", "starter":
+		return size.StarterSet, true
+	case "small":
+		return size.Small, true
+	case "medium":
+		return size.Medium, true
+	case "large", "production":
+		return size.Large, true
+	}
+	return "", false
+}
+
+// applySizeConfigs returns the services of the CR as they are, the sizeSpecs
+// are the services decoded in the same order
+func applySizeConfigs(cs *unstructured.Unstructured, sizeSpecs []ServiceSizeSpec, serviceControllerMapping map[string]string) ([]interface{}, map[string]string) {
 	var dest []interface{}
 
 	if cs.Object["spec"].(map[string]interface{})["services"] != nil {
-		for _, configSize := range cs.Object["spec"].(map[string]interface{})["services"].([]interface{}) {
-			if controller, ok := configSize.(map[string]interface{})["managementStrategy"]; ok {
-				serviceControllerMapping[configSize.(map[string]interface{})["name"].(string)] = controller.(string)
+		for i, configSize := range cs.Object["spec"].(map[string]interface{})["services"].([]interface{}) {
+			if _, ok := configSize.(map[string]interface{})["managementStrategy"]; ok {
+				serviceControllerMapping[sizeSpecs[i].Name] = sizeSpecs[i].ManagementStrategy
 			}
 			dest = append(dest, configSize)
 		}
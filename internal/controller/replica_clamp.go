@@ -0,0 +1,103 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+)
+
+const (
+	// MinReplicasRuleKey is the lower bound of the replicas of an operator
+	MinReplicasRuleKey = "minReplicas"
+	// MaxReplicasRuleKey is the upper bound of the replicas of an operator
+	MaxReplicasRuleKey = "maxReplicas"
+)
+
+// clampReplicas clamps the merged replicas of the operators into the bounds
+// set in their rules, guarding against the extreme requests of a CR
+func clampReplicas(logger logr.Logger, opconServices, ruleSlice []interface{}) []interface{} {
+	for _, opService := range opconServices {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rule, ok := getItemByIdentity(ruleSlice, opService).(map[string]interface{})
+		if !ok {
+			continue
+		}
+		minReplicas, hasMin := replicasValue(rule[MinReplicasRuleKey])
+		maxReplicas, hasMax := replicasValue(rule[MaxReplicasRuleKey])
+		if !hasMin && !hasMax {
+			continue
+		}
+		spec, ok := opServiceMap["spec"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := opServiceMap["name"].(string)
+		clampReplicasInMap(logger, name, spec, minReplicas, hasMin, maxReplicas, hasMax)
+	}
+	return opconServices
+}
+
+func clampReplicasInMap(logger logr.Logger, operator string, m map[string]interface{}, minReplicas float64, hasMin bool, maxReplicas float64, hasMax bool) {
+	for key, value := range m {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			clampReplicasInMap(logger, operator, valueMap, minReplicas, hasMin, maxReplicas, hasMax)
+			continue
+		}
+		if key != "replicas" {
+			continue
+		}
+		replicas, ok := replicasValue(value)
+		if !ok {
+			continue
+		}
+		clamped := replicas
+		if hasMax && clamped > maxReplicas {
+			clamped = maxReplicas
+		}
+		if hasMin && clamped < minReplicas {
+			clamped = minReplicas
+		}
+		if clamped == replicas {
+			continue
+		}
+		logger.Info("Clamping the replicas", "operator", operator, "replicas", replicas, "clamped", clamped)
+		// Keep the number type of the replicas
+		switch value.(type) {
+		case int64:
+			m[key] = int64(clamped)
+		case int:
+			m[key] = int(clamped)
+		default:
+			m[key] = clamped
+		}
+	}
+}
+
+func replicasValue(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case int64:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	}
+	return 0, false
+}
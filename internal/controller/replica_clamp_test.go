@@ -0,0 +1,83 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Replica clamping", func() {
+	var services []interface{}
+
+	BeforeEach(func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  minReplicas: 1
+  maxReplicas: 10
+  spec:
+    mongoDB:
+      replicas: LARGEST_VALUE
+      metrics:
+        replicas: LARGEST_VALUE
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+`)
+		tenant := newTestCommonServiceObject("tenant", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 50
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        replicas: 50
+`)
+		r := newTestReconciler(tenant)
+		var err error
+		services, err = r.getExtremeizes(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 0
+      metrics:
+        replicas: 0
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should clamp the replicas to the bounds of the operator", func() {
+		mongoDB := getItemByName(services, "ibm-im-mongodb-operator").(map[string]interface{})["spec"].(map[string]interface{})["mongoDB"].(map[string]interface{})
+		// The requested 50 replicas are clamped to the max, and 0 is raised to the min
+		Expect(mongoDB["replicas"]).To(BeEquivalentTo(10))
+		Expect(mongoDB["metrics"].(map[string]interface{})["replicas"]).To(BeEquivalentTo(1))
+	})
+
+	It("should not clamp the replicas of the operators without bounds", func() {
+		testCR := getItemByName(services, "ibm-test-operator").(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})
+		Expect(testCR["replicas"]).To(BeEquivalentTo(50))
+	})
+})
@@ -0,0 +1,68 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"reflect"
+
+	"github.com/go-logr/logr"
+
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+// alignRequestsWithLimits raises the limits lower than the requests of the
+// same resource in the merged services. The requests and the limits are merged
+// independently, so a CR raising only the requests could leave them above the
+// limits, which is rejected by Kubernetes.
+func alignRequestsWithLimits(logger logr.Logger, opconServices []interface{}) []interface{} {
+	for _, opService := range opconServices {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := opServiceMap["name"].(string)
+		alignRequestsWithLimitsIn(logger, name, opServiceMap)
+	}
+	return opconServices
+}
+
+func alignRequestsWithLimitsIn(logger logr.Logger, operator string, value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		limits, hasLimits := value["limits"].(map[string]interface{})
+		requests, hasRequests := value["requests"].(map[string]interface{})
+		if hasLimits && hasRequests {
+			for key, request := range requests {
+				limit, ok := limits[key]
+				if !ok || limit == nil || request == nil || rules.ResourceEqualComparison(request, limit) {
+					continue
+				}
+				if larger, _ := rules.ResourceComparison(request, limit); reflect.DeepEqual(larger, request) {
+					logger.Info("Raising the limit to the request", "operator", operator, "resource", key, "limit", limit, "request", request)
+					limits[key] = request
+				}
+			}
+		}
+		for _, v := range value {
+			alignRequestsWithLimitsIn(logger, operator, v)
+		}
+	case []interface{}:
+		for _, v := range value {
+			alignRequestsWithLimitsIn(logger, operator, v)
+		}
+	}
+}
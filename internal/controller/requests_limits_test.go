@@ -0,0 +1,100 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("getExtremeizes with limits and requests", func() {
+	var (
+		ruleSlice     []interface{}
+		opconServices = `
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: "1"
+          memory: 1Gi
+        requests:
+          cpu: 500m
+          memory: 512Mi
+`
+	)
+
+	limitsAndRequests := func(services []interface{}) (map[string]interface{}, map[string]interface{}) {
+		resources := getItemByName(services, "ibm-test-operator").(map[string]interface{})["spec"].(map[string]interface{})["testCR"].(map[string]interface{})["resources"].(map[string]interface{})
+		return resources["limits"].(map[string]interface{}), resources["requests"].(map[string]interface{})
+	}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+        requests:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+	})
+
+	It("should merge each value against its counterpart only", func() {
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        resources:
+          limits:
+            cpu: 800m
+            memory: 2Gi
+          requests:
+            cpu: 100m
+            memory: 1Gi
+`)
+		services, err := newTestReconciler(tenant).getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		limits, requests := limitsAndRequests(services)
+		Expect(limits).To(Equal(map[string]interface{}{"cpu": "1", "memory": "2Gi"}))
+		Expect(requests).To(Equal(map[string]interface{}{"cpu": "500m", "memory": "1Gi"}))
+	})
+
+	It("should raise the limits to the larger requests", func() {
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        resources:
+          requests:
+            cpu: "2"
+`)
+		services, err := newTestReconciler(tenant).getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, Max)
+		Expect(err).NotTo(HaveOccurred())
+		limits, requests := limitsAndRequests(services)
+		Expect(limits).To(Equal(map[string]interface{}{"cpu": "2", "memory": "1Gi"}))
+		Expect(requests).To(Equal(map[string]interface{}{"cpu": "2", "memory": "512Mi"}))
+	})
+})
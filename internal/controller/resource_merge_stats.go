@@ -0,0 +1,97 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"sort"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// ResourceMergeResultMerged labels the resources entries merged
+	ResourceMergeResultMerged = "merged"
+	// ResourceMergeResultSkipped labels the resources entries skipped, because
+	// they are not objects or their apiVersion, kind or name is not set
+	ResourceMergeResultSkipped = "skipped"
+)
+
+// resourceMergeCounts counts the resources entries of an operator
+type resourceMergeCounts struct {
+	Merged  int
+	Skipped int
+}
+
+// countResourceEntries counts, per operator, the resources entries of the
+// configs which can be merged, and the ones skipped by the merge, because they
+// are not objects or their apiVersion, kind or name is not set
+func countResourceEntries(configs []interface{}) map[string]*resourceMergeCounts {
+	counts := map[string]*resourceMergeCounts{}
+	for _, config := range configs {
+		configMap, ok := config.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		resources, ok := configMap["resources"].([]interface{})
+		if !ok || len(resources) == 0 {
+			continue
+		}
+		operator, _ := configMap["name"].(string)
+		if counts[operator] == nil {
+			counts[operator] = &resourceMergeCounts{}
+		}
+		for _, resource := range resources {
+			resourceMap, ok := resource.(map[string]interface{})
+			if !ok {
+				counts[operator].Skipped++
+				continue
+			}
+			apiVersion, _ := resourceMap["apiVersion"].(string)
+			kind, _ := resourceMap["kind"].(string)
+			name, _ := resourceMap["name"].(string)
+			if apiVersion == "" || kind == "" || name == "" {
+				counts[operator].Skipped++
+				continue
+			}
+			counts[operator].Merged++
+		}
+	}
+	return counts
+}
+
+// reportResourceEntries logs the summary of the resources entries of the
+// configs of a CommonService CR per operator, and counts them in the metric.
+// A CR whose entries are all skipped stands out, instead of silently losing
+// its resources.
+func reportResourceEntries(logger logr.Logger, source string, configs []interface{}) {
+	counts := countResourceEntries(configs)
+	operators := make([]string, 0, len(counts))
+	for operator := range counts {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+	for _, operator := range operators {
+		count := counts[operator]
+		resourcesMergeTotal.WithLabelValues(operator, ResourceMergeResultMerged).Add(float64(count.Merged))
+		resourcesMergeTotal.WithLabelValues(operator, ResourceMergeResultSkipped).Add(float64(count.Skipped))
+		if count.Skipped > 0 {
+			logger.Info("Skipped some resources of the CommonService, because they are not objects or their apiVersion, kind or name is not set", "cr", source, "operator", operator, "merged", count.Merged, "skipped", count.Skipped)
+			continue
+		}
+		logger.V(2).Info("Merged the resources of the CommonService", "cr", source, "operator", operator, "merged", count.Merged)
+	}
+}
@@ -0,0 +1,73 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("reportResourceEntries", func() {
+	var configs []interface{}
+
+	BeforeEach(func() {
+		configs = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: valid-config
+    data:
+      data:
+        size: "1"
+  - kind: ConfigMap
+    name: missing-api-version
+  - apiVersion: v1
+    name: missing-kind
+  - not an object
+- name: ibm-valid-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: first-config
+  - apiVersion: v1
+    kind: ConfigMap
+    name: second-config
+- name: ibm-spec-operator
+  spec:
+    testCR:
+      replicas: 1
+`)
+	})
+
+	It("should count the merged and the skipped resources of each operator", func() {
+		Expect(countResourceEntries(configs)).To(Equal(map[string]*resourceMergeCounts{
+			"ibm-test-operator":  {Merged: 1, Skipped: 3},
+			"ibm-valid-operator": {Merged: 2, Skipped: 0},
+		}))
+	})
+
+	It("should add the counts to the metrics", func() {
+		mergedBefore := testutil.ToFloat64(resourcesMergeTotal.WithLabelValues("ibm-test-operator", ResourceMergeResultMerged))
+		skippedBefore := testutil.ToFloat64(resourcesMergeTotal.WithLabelValues("ibm-test-operator", ResourceMergeResultSkipped))
+		reportResourceEntries(logr.Discard(), "tenant-a/example-service", configs)
+		Expect(testutil.ToFloat64(resourcesMergeTotal.WithLabelValues("ibm-test-operator", ResourceMergeResultMerged))).To(Equal(mergedBefore + 1))
+		Expect(testutil.ToFloat64(resourcesMergeTotal.WithLabelValues("ibm-test-operator", ResourceMergeResultSkipped))).To(Equal(skippedBefore + 3))
+	})
+})
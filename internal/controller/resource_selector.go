@@ -0,0 +1,148 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LabelSelectorKey is the key of a resource entry selecting the resource by
+// its labels instead of its name
+const LabelSelectorKey = "labelSelector"
+
+// readableResourceKinds are the kinds of the resource entries whose objects the
+// operator looks up, the role of the operator grants get and list on them.
+// Keep it in sync with config/rbac/role.yaml and the rbac of the helm charts.
+var readableResourceKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: "ConfigMap"}:                  true,
+	{Group: "", Kind: "Secret"}:                     true,
+	{Group: "", Kind: "Service"}:                    true,
+	{Group: "", Kind: "ServiceAccount"}:             true,
+	{Group: "apps", Kind: "DaemonSet"}:              true,
+	{Group: "apps", Kind: "Deployment"}:             true,
+	{Group: "apps", Kind: "StatefulSet"}:            true,
+	{Group: "cert-manager.io", Kind: "Certificate"}: true,
+	{Group: "cert-manager.io", Kind: "Issuer"}:      true,
+}
+
+// isReadableResourceKind checks whether the operator can read the objects of
+// the kind
+func isReadableResourceKind(apiVersion, kind string) bool {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return false
+	}
+	return readableResourceKinds[gv.WithKind(kind).GroupKind()]
+}
+
+// resolveResourceSelectors resolves the resource entries selected by labels to
+// the name of the single object matching the selector, so they are merged like
+// the resources named in the CR. The entries matching no object or more than
+// one are dropped, so are the entries of a kind the operator can't read.
+func (r *CommonServiceReconciler) resolveResourceSelectors(ctx context.Context, configs []interface{}) ([]interface{}, error) {
+	for _, config := range configs {
+		service, ok := config.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		resources, ok := service["resources"].([]interface{})
+		if !ok {
+			continue
+		}
+		var resolved []interface{}
+		for _, resource := range resources {
+			resourceMap, ok := resource.(map[string]interface{})
+			if !ok || resourceMap[LabelSelectorKey] == nil {
+				resolved = append(resolved, resource)
+				continue
+			}
+			name, err := r.resolveResourceName(ctx, resourceMap)
+			if err != nil {
+				return nil, err
+			}
+			if name == "" {
+				continue
+			}
+			delete(resourceMap, LabelSelectorKey)
+			resourceMap["name"] = name
+			resolved = append(resolved, resourceMap)
+		}
+		service["resources"] = resolved
+	}
+	return configs, nil
+}
+
+// resolveResourceName returns the name of the object selected by the resource
+// entry, or an empty name when the selector doesn't match exactly one object
+func (r *CommonServiceReconciler) resolveResourceName(ctx context.Context, resource map[string]interface{}) (string, error) {
+	apiVersion, _ := resource["apiVersion"].(string)
+	kind, _ := resource["kind"].(string)
+	namespace, _ := resource["namespace"].(string)
+	if namespace == "" {
+		namespace = r.Bootstrap.CSData.ServicesNs
+	}
+	if apiVersion == "" || kind == "" {
+		klog.Warningf("Skipping resource selected by %v, because apiVersion or kind is not set", resource[LabelSelectorKey])
+		return "", nil
+	}
+	if !isReadableResourceKind(apiVersion, kind) {
+		klog.Warningf("Skipping resource %s/%s in namespace %s selected by %v, because the operator is not allowed to list its kind", apiVersion, kind, namespace, resource[LabelSelectorKey])
+		return "", nil
+	}
+
+	labelSelector := &metav1.LabelSelector{}
+	selectorMap, ok := resource[LabelSelectorKey].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("the labelSelector of resource %s/%s in namespace %s is not an object", apiVersion, kind, namespace)
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, labelSelector); err != nil {
+		return "", fmt.Errorf("failed to convert the labelSelector of resource %s/%s in namespace %s: %v", apiVersion, kind, namespace, err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return "", fmt.Errorf("invalid labelSelector of resource %s/%s in namespace %s: %v", apiVersion, kind, namespace, err)
+	}
+
+	objs := &unstructured.UnstructuredList{}
+	objs.SetGroupVersionKind(schema.FromAPIVersionAndKind(apiVersion, kind+"List"))
+	if err := r.Client.List(ctx, objs, &client.ListOptions{
+		Namespace:     namespace,
+		LabelSelector: selector,
+	}); err != nil {
+		klog.Errorf("failed to list %s/%s in namespace %s by labelSelector %s: %v", apiVersion, kind, namespace, selector.String(), err)
+		return "", err
+	}
+
+	switch len(objs.Items) {
+	case 0:
+		klog.Warningf("Skipping resource %s/%s in namespace %s, because no object matches labelSelector %s", apiVersion, kind, namespace, selector.String())
+		return "", nil
+	case 1:
+		klog.V(2).Infof("Resolved resource %s/%s in namespace %s by labelSelector %s to %s", apiVersion, kind, namespace, selector.String(), objs.Items[0].GetName())
+		return objs.Items[0].GetName(), nil
+	}
+	klog.Warningf("Skipping resource %s/%s in namespace %s, because %d objects match labelSelector %s", apiVersion, kind, namespace, len(objs.Items), selector.String())
+	return "", nil
+}
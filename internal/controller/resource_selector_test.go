@@ -0,0 +1,127 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("resolveResourceSelectors", func() {
+	var configs []interface{}
+
+	BeforeEach(func() {
+		newConfigMap := func(name string, labels map[string]string) *corev1.ConfigMap {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testServicesNs, Labels: labels},
+			}
+		}
+		r := newTestReconciler(
+			newConfigMap("mongodb-config-x7k2p", map[string]string{"app": "mongodb"}),
+			newConfigMap("postgres-config-a1b2c", map[string]string{"app": "postgres"}),
+		)
+		var err error
+		configs, err = r.resolveResourceSelectors(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    labelSelector:
+      matchLabels:
+        app: mongodb
+    data:
+      data:
+        size: 3
+  - apiVersion: v1
+    kind: ConfigMap
+    labelSelector:
+      matchLabels:
+        app: keycloak
+    data:
+      data:
+        size: 3
+  - apiVersion: v1
+    kind: ConfigMap
+    name: named-config
+    data:
+      data:
+        size: 3
+`))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should resolve the selector to the name of the matching object", func() {
+		resources := configs[0].(map[string]interface{})["resources"].([]interface{})
+		// The selector matching no object is dropped, the named resource is kept
+		Expect(resources).To(HaveLen(2))
+		Expect(resources[0].(map[string]interface{})["name"]).To(Equal("mongodb-config-x7k2p"))
+		Expect(resources[0].(map[string]interface{})).NotTo(HaveKey(LabelSelectorKey))
+		Expect(resources[1].(map[string]interface{})["name"]).To(Equal("named-config"))
+	})
+
+	It("should merge the resolved resource like a named one", func() {
+		opconServices := mustMergeNewConfigs(logr.Discard(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  resources:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: mongodb-config-x7k2p
+    data:
+      data:
+        size: 1
+`), configs, nil, map[string]string{}, testServicesNs, 1)
+		Expect(opconServices[0].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})["data"].(map[string]interface{})["data"].(map[string]interface{})["size"]).To(BeEquivalentTo(3))
+	})
+	It("should drop the selector of a kind the operator can't list without listing it", func() {
+		r := newTestReconciler()
+		lists := 0
+		c := newHookClient(r)
+		c.list = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			lists++
+			return c.Client.List(ctx, list, opts...)
+		}
+		configs, err := r.resolveResourceSelectors(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  resources:
+  - apiVersion: v1
+    kind: Pod
+    labelSelector:
+      matchLabels:
+        app: mongodb
+    data:
+      spec:
+        priority: 1
+  - apiVersion: example.com/v1
+    kind: Widget
+    labelSelector:
+      matchLabels:
+        app: mongodb
+    data:
+      spec:
+        size: 3
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configs[0].(map[string]interface{})["resources"]).To(BeEmpty())
+		Expect(lists).To(BeZero())
+	})
+})
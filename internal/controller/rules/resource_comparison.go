@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog"
@@ -54,7 +55,95 @@ func normalizeResourceQuantity(quantity string) string {
 	return quantity
 }
 
+// percentageComparison compares two percentage values like "75%" numerically,
+// the original strings are returned so the "%" suffix is kept
+func percentageComparison(resourceA, resourceB string) (string, string, error) {
+	percentA, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(resourceA, "%")), 64)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse percentage %s: %v", resourceA, err)
+	}
+	percentB, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(resourceB, "%")), 64)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse percentage %s: %v", resourceB, err)
+	}
+	if percentA > percentB {
+		return resourceA, resourceB, nil
+	}
+	if percentA == percentB {
+		large, small := tieBreak(resourceA, resourceB)
+		return large, small, nil
+	}
+	return resourceB, resourceA, nil
+}
+
+// tieBreak orders two equal values written differently, e.g. "1Gi" and
+// "1024Mi". The lexicographically smaller string wins either way, so the
+// winner doesn't depend on the order of the comparison and doesn't flip
+// between reconciles.
+func tieBreak(resourceA, resourceB string) (string, string) {
+	if resourceB < resourceA {
+		return resourceB, resourceA
+	}
+	return resourceA, resourceB
+}
+
+// quantityString returns the string of a quantity written as a string or as a
+// number
+func quantityString(resource interface{}) (string, bool) {
+	switch resource := resource.(type) {
+	case string:
+		return resource, true
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", resource), true
+	}
+	return "", false
+}
+
+// integerValue returns the value of an integer written as an integer type, a
+// whole float or a string of digits
+func integerValue(resource interface{}) (int64, bool) {
+	switch resource := resource.(type) {
+	case int:
+		return int64(resource), true
+	case int8:
+		return int64(resource), true
+	case int16:
+		return int64(resource), true
+	case int32:
+		return int64(resource), true
+	case int64:
+		return resource, true
+	case uint8:
+		return int64(resource), true
+	case uint16:
+		return int64(resource), true
+	case uint32:
+		return int64(resource), true
+	case float32:
+		if float32(int64(resource)) == resource {
+			return int64(resource), true
+		}
+	case float64:
+		if float64(int64(resource)) == resource {
+			return int64(resource), true
+		}
+	case string:
+		if value, err := strconv.ParseInt(strings.TrimSpace(resource), 10, 64); err == nil {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
 func resourceStringComparison(resourceA, resourceB string) (string, string, error) {
+	// Percentages are not resource quantities, compare them as numbers
+	if strings.HasSuffix(resourceA, "%") || strings.HasSuffix(resourceB, "%") {
+		if !strings.HasSuffix(resourceA, "%") || !strings.HasSuffix(resourceB, "%") {
+			return "", "", fmt.Errorf("failed to compare resources %s and %s, only one of them is a percentage", resourceA, resourceB)
+		}
+		return percentageComparison(resourceA, resourceB)
+	}
+
 	if sizeA, ok := profileSize[resourceA]; ok {
 		if sizeB, ok := profileSize[resourceB]; ok {
 			if sizeA > sizeB {
@@ -67,8 +156,8 @@ func resourceStringComparison(resourceA, resourceB string) (string, string, erro
 	}
 
 	// Normalize the resource quantities to handle formats like "96MB" -> "96M"
-	normalizedA := normalizeResourceQuantity(resourceA)
-	normalizedB := normalizeResourceQuantity(resourceB)
+	normalizedA := normalizeResourceQuantity(strings.TrimSpace(resourceA))
+	normalizedB := normalizeResourceQuantity(strings.TrimSpace(resourceB))
 
 	quantityA, err := resource.ParseQuantity(normalizedA)
 	if err != nil {
@@ -78,8 +167,12 @@ func resourceStringComparison(resourceA, resourceB string) (string, string, erro
 	if err != nil {
 		return "", "", err
 	}
-	if quantityA.Cmp(quantityB) > 0 {
+	switch quantityA.Cmp(quantityB) {
+	case 1:
 		return resourceA, resourceB, nil
+	case 0:
+		large, small := tieBreak(resourceA, resourceB)
+		return large, small, nil
 	}
 	return resourceB, resourceA, nil
 }
@@ -89,13 +182,41 @@ func ResourceComparison(resourceA, resourceB interface{}) (interface{}, interfac
 	klog.V(3).Infof("Kind of A %s", reflect.TypeOf(resourceA).Kind())
 	klog.V(3).Infof("Kind of B %s", reflect.TypeOf(resourceB).Kind())
 
-	switch resourceA.(type) {
-	case string:
-		large, small, err := resourceStringComparison(resourceA.(string), resourceB.(string))
+	// Integers like max_connections are compared exactly, without going through
+	// the float or the quantity parsing
+	if intA, ok := integerValue(resourceA); ok {
+		if intB, ok := integerValue(resourceB); ok {
+			if intA > intB {
+				return resourceA, resourceB
+			}
+			return resourceB, resourceA
+		}
+	}
+
+	// A quantity may be written as a number on one side, e.g. cpu 1 and "800m",
+	// both sides are compared as quantities then
+	_, isStringA := resourceA.(string)
+	_, isStringB := resourceB.(string)
+	if isStringA || isStringB {
+		strA, okA := quantityString(resourceA)
+		strB, okB := quantityString(resourceB)
+		if !okA || !okB {
+			klog.Errorf("failed to compare resources %v and %v", resourceA, resourceB)
+			return resourceA, resourceA
+		}
+		large, _, err := resourceStringComparison(strA, strB)
 		if err != nil {
 			klog.Error(err)
+			return "", ""
+		}
+		// Return the original values, so the format of the winner is kept
+		if large == strA {
+			return resourceA, resourceB
 		}
-		return large, small
+		return resourceB, resourceA
+	}
+
+	switch resourceA.(type) {
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
 		strA := fmt.Sprintf("%v", resourceA)
 		strB := fmt.Sprintf("%v", resourceB)
@@ -123,12 +244,60 @@ func ResourceComparison(resourceA, resourceB interface{}) (interface{}, interfac
 	}
 }
 
+// quantityEqual compares the values as resource quantities when one of them
+// has a unit, so "1Gi" equals "1024Mi" and 1 equals "1000m". The plain numbers
+// and the other strings are left to the exact comparison, ok is false then.
+func quantityEqual(resourceA, resourceB interface{}) (equal, ok bool) {
+	strA, okA := quantityString(resourceA)
+	strB, okB := quantityString(resourceB)
+	if !okA || !okB {
+		return false, false
+	}
+	_, errA := strconv.ParseFloat(strA, 64)
+	_, errB := strconv.ParseFloat(strB, 64)
+	if errA == nil && errB == nil {
+		return false, false
+	}
+	quantityA, err := resource.ParseQuantity(normalizeResourceQuantity(strings.TrimSpace(strA)))
+	if err != nil {
+		return false, false
+	}
+	quantityB, err := resource.ParseQuantity(normalizeResourceQuantity(strings.TrimSpace(strB)))
+	if err != nil {
+		return false, false
+	}
+	return quantityA.Cmp(quantityB) == 0, true
+}
+
+// isAbsentResource tells whether the resource is missing, or is the empty
+// marker, struct{}{} or an empty object once serialized, left for a deleted key
+func isAbsentResource(resource interface{}) bool {
+	switch resource := resource.(type) {
+	case nil:
+		return true
+	case struct{}:
+		return true
+	case map[string]interface{}:
+		return len(resource) == 0
+	}
+	return false
+}
+
 func ResourceEqualComparison(resourceA interface{}, resourceB interface{}) bool {
+	// The cpu limit deleted for a non-default profile controller may still be
+	// left as an empty marker in the existing config
+	if isAbsentResource(resourceA) && isAbsentResource(resourceB) {
+		return true
+	}
 
 	if resourceA != nil && resourceB != nil {
 		klog.V(3).Infof("Kind of A %s", reflect.TypeOf(resourceA).Kind())
 		klog.V(3).Infof("Kind of B %s", reflect.TypeOf(resourceB).Kind())
 
+		if equal, ok := quantityEqual(resourceA, resourceB); ok {
+			return equal
+		}
+
 		isEqual := true
 		switch resourceA := resourceA.(type) {
 		case []interface{}:
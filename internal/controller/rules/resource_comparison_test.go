@@ -17,6 +17,8 @@
 package rules
 
 import (
+	"fmt"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -68,4 +70,160 @@ var _ = Describe("Resource Comparison", func() {
 			Expect(result).Should(Equal(expectedResult))
 		})
 	})
+
+	Context("Compare Storage", func() {
+		It("Should 20Gi be larger than 10Gi", func() {
+			large, small := ResourceComparison("10Gi", "20Gi")
+
+			Expect(large).Should(Equal("20Gi"))
+			Expect(small).Should(Equal("10Gi"))
+		})
+		It("Should 1Gi be larger than 1G", func() {
+			large, small := ResourceComparison("1G", "1Gi")
+
+			Expect(large).Should(Equal("1Gi"))
+			Expect(small).Should(Equal("1G"))
+		})
+		It("Should 20G be larger than 10Gi", func() {
+			large, small := ResourceComparison("10Gi", "20G")
+
+			Expect(large).Should(Equal("20G"))
+			Expect(small).Should(Equal("10Gi"))
+		})
+	})
+
+	Context("Compare Percentage", func() {
+		It("Should 75% be larger than 50%", func() {
+			A := "50%"
+			B := "75%"
+			expectedResult := "75%"
+
+			result, _, err := resourceStringComparison(A, B)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(result).Should(Equal(expectedResult))
+		})
+		It("Should merge 50% and 75% into 75%", func() {
+			large, small := ResourceComparison("50%", "75%")
+
+			Expect(large).Should(Equal("75%"))
+			Expect(small).Should(Equal("50%"))
+		})
+		It("Should fail to compare a percentage with a quantity", func() {
+			_, _, err := resourceStringComparison("50%", "100m")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Compare Millicore and Core", func() {
+		matrix := []struct {
+			a, b, large, small interface{}
+		}{
+			{"1", "999m", "1", "999m"},
+			{"999m", "1", "1", "999m"},
+			{"1500m", "2", "2", "1500m"},
+			{"0.5", "600m", "600m", "0.5"},
+			{int64(1), "999m", int64(1), "999m"},
+			{"1500m", float64(1), "1500m", float64(1)},
+			{"1Gi", "1000Mi", "1Gi", "1000Mi"},
+			{"1025Mi", "1Gi", "1025Mi", "1Gi"},
+		}
+		for _, entry := range matrix {
+			entry := entry
+			It(fmt.Sprintf("Should %v be larger than %v", entry.large, entry.small), func() {
+				large, small := ResourceComparison(entry.a, entry.b)
+
+				Expect(large).Should(Equal(entry.large))
+				Expect(small).Should(Equal(entry.small))
+			})
+		}
+
+		It("Should keep the format of equal values", func() {
+			large, small := ResourceComparison("250m", "0.25")
+			Expect([]interface{}{large, small}).Should(ConsistOf("250m", "0.25"))
+
+			large, small = ResourceComparison("1Gi", "1024Mi")
+			Expect([]interface{}{large, small}).Should(ConsistOf("1Gi", "1024Mi"))
+		})
+	})
+
+	Context("Compare Integers", func() {
+		It("Should max_connections 200 be larger than 100", func() {
+			large, small := ResourceComparison(int64(100), int64(200))
+
+			Expect(large).Should(Equal(int64(200)))
+			Expect(small).Should(Equal(int64(100)))
+		})
+		It("Should compare integers written as strings and numbers", func() {
+			large, small := ResourceComparison("200", float64(100))
+
+			Expect(large).Should(Equal("200"))
+			Expect(small).Should(Equal(float64(100)))
+		})
+		It("Should compare large integers exactly", func() {
+			large, small := ResourceComparison(int64(9007199254740993), int64(9007199254740992))
+
+			Expect(large).Should(Equal(int64(9007199254740993)))
+			Expect(small).Should(Equal(int64(9007199254740992)))
+		})
+		It("Should shared_buffers 512MB be larger than 256MB", func() {
+			large, small := ResourceComparison("512MB", "256MB")
+
+			Expect(large).Should(Equal("512MB"))
+			Expect(small).Should(Equal("256MB"))
+		})
+	})
+
+	Context("Compare Equality", func() {
+		It("Should 1Gi equal 1024Mi", func() {
+			Expect(ResourceEqualComparison("1Gi", "1024Mi")).Should(BeTrue())
+		})
+		It("Should 1 equal 1000m", func() {
+			Expect(ResourceEqualComparison("1", "1000m")).Should(BeTrue())
+			Expect(ResourceEqualComparison(int64(1), "1000m")).Should(BeTrue())
+		})
+		It("Should 512MB equal 512M", func() {
+			Expect(ResourceEqualComparison("512MB", "512M")).Should(BeTrue())
+		})
+		It("Should 1Gi not equal 2Gi", func() {
+			Expect(ResourceEqualComparison("1Gi", "2Gi")).Should(BeFalse())
+			Expect(ResourceEqualComparison("1Gi", "1G")).Should(BeFalse())
+		})
+		It("Should compare the nested quantities", func() {
+			Expect(ResourceEqualComparison(
+				map[string]interface{}{"limits": map[string]interface{}{"cpu": "1", "memory": "1Gi"}},
+				map[string]interface{}{"limits": map[string]interface{}{"cpu": "1000m", "memory": "1024Mi"}},
+			)).Should(BeTrue())
+		})
+		It("Should compare the plain strings exactly", func() {
+			Expect(ResourceEqualComparison("1.0", "1")).Should(BeFalse())
+			Expect(ResourceEqualComparison("small", "small")).Should(BeTrue())
+			Expect(ResourceEqualComparison("small", "large")).Should(BeFalse())
+		})
+	})
+
+	Context("Break Ties", func() {
+		It("Should pick the same winner of equal quantities in any order", func() {
+			for i := 0; i < 10; i++ {
+				large, small := ResourceComparison("1Gi", "1024Mi")
+				Expect(large).Should(Equal("1024Mi"))
+				Expect(small).Should(Equal("1Gi"))
+				large, small = ResourceComparison("1024Mi", "1Gi")
+				Expect(large).Should(Equal("1024Mi"))
+				Expect(small).Should(Equal("1Gi"))
+			}
+		})
+		It("Should pick the same winner of a quantity written as a number", func() {
+			large, _ := ResourceComparison("1000m", int64(1))
+			Expect(large).Should(Equal(int64(1)))
+			large, _ = ResourceComparison(int64(1), "1000m")
+			Expect(large).Should(Equal(int64(1)))
+		})
+		It("Should pick the same winner of equal percentages", func() {
+			large, _ := ResourceComparison("75%", "75.0%")
+			Expect(large).Should(Equal("75%"))
+			large, _ = ResourceComparison("75.0%", "75%")
+			Expect(large).Should(Equal("75%"))
+		})
+	})
 })
@@ -0,0 +1,172 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"reflect"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+// deletedCommonServices keeps the last state of the deleted CommonService CRs
+// until their deletion is reconciled, the CRs are gone from the cluster then
+var deletedCommonServices sync.Map
+
+// deletedCommonServicePredicate records the deleted CommonService CRs, so the
+// deletion can be scoped to the operators they configured
+func deletedCommonServicePredicate() predicate.Funcs {
+	return predicate.Funcs{
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			if cs, ok := e.Object.(*apiv3.CommonService); ok {
+				deletedCommonServices.Store(types.NamespacedName{Namespace: cs.GetNamespace(), Name: cs.GetName()}, cs.DeepCopy())
+			}
+			return true
+		},
+	}
+}
+
+// popDeletedCommonService returns the last state of the deleted CommonService
+// CR, or nil when its delete event is not observed
+func popDeletedCommonService(key types.NamespacedName) *apiv3.CommonService {
+	cs, ok := deletedCommonServices.LoadAndDelete(key)
+	if !ok {
+		return nil
+	}
+	return cs.(*apiv3.CommonService)
+}
+
+// getDeletedConfigs returns the configs of the deleted CommonService CR and its
+// profile controller mapping, its operators are the only ones which could
+// shrink
+func (r *CommonServiceReconciler) getDeletedConfigs(instance *apiv3.CommonService, ruleSlice []interface{}) ([]interface{}, map[string]string, error) {
+	contents, err := runtime.DefaultUnstructuredConverter.ToUnstructured(instance)
+	if err != nil {
+		return nil, nil, err
+	}
+	cs := &unstructured.Unstructured{Object: contents}
+	if cs.Object["spec"] == nil {
+		cs.Object["spec"] = map[string]interface{}{}
+	}
+
+	return r.buildNewConfigs(cs, instance, ruleSlice)
+}
+
+// isDeletedConfigsDominated checks if every value set by the deleted
+// CommonService CR is below the value in the OperandConfig services. The
+// OperandConfig holds the largest value of the CRs, so a smaller value is
+// dominated by a peer and the deletion can't shrink it. The CRs handing the
+// operators off to a non-default profile controller are never dominated.
+func isDeletedConfigsDominated(configs []interface{}, serviceControllerMapping map[string]string, opconServices []interface{}, opconNs string) bool {
+	for _, controller := range serviceControllerMapping {
+		if isNonDefaultProfileController(controller) {
+			return false
+		}
+	}
+	for _, config := range configs {
+		configMap, ok := config.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		opService, ok := getItemByIdentity(opconServices, config).(map[string]interface{})
+		if !ok {
+			// The operator is not in the OperandConfig, nothing to shrink
+			continue
+		}
+		if !isValueDominated(configMap["spec"], opService["spec"]) {
+			return false
+		}
+		resources, _ := configMap["resources"].([]interface{})
+		opResources, _ := opService["resources"].([]interface{})
+		for _, resource := range resources {
+			resourceMap, ok := resource.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			apiVersion, _ := resourceMap["apiVersion"].(string)
+			kind, _ := resourceMap["kind"].(string)
+			name, _ := resourceMap["name"].(string)
+			namespace, _ := resourceMap["namespace"].(string)
+			if namespace == "" {
+				namespace = opconNs
+			}
+			opResource, ok := getItemByGVKNameNamespace(opResources, opconNs, apiVersion, kind, name, namespace).(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if !isValueDominated(resourceMap["data"], opResource["data"]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isValueDominated checks if every leaf of the value is smaller than the leaf
+// at the same path of the OperandConfig value. The leaves missing from the
+// OperandConfig are never merged, so they are dominated.
+func isValueDominated(value, opconValue interface{}) bool {
+	if value == nil || opconValue == nil {
+		return true
+	}
+	switch value := value.(type) {
+	case map[string]interface{}:
+		opconMap, ok := opconValue.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, v := range value {
+			if !isValueDominated(v, opconMap[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		// The items are merged by index, any of them could be the largest
+		return false
+	default:
+		// The equal values, even written differently, may come from the CR
+		if rules.ResourceEqualComparison(value, opconValue) {
+			return false
+		}
+		larger, _ := rules.ResourceComparison(value, opconValue)
+		return reflect.DeepEqual(larger, opconValue)
+	}
+}
+
+// scopeServices returns the OperandConfig services of the given operators. The
+// services are shared with the OperandConfig, so shrinking them in place
+// leaves the others untouched.
+func scopeServices(opconServices []interface{}, operators []string) []interface{} {
+	var scoped []interface{}
+	for _, operator := range operators {
+		if service := getItemByName(opconServices, operator); service != nil {
+			scoped = append(scoped, service)
+		} else {
+			klog.V(2).Infof("Operator %s configured by the deleted CommonService is not found in OperandConfig", operator)
+		}
+	}
+	return scoped
+}
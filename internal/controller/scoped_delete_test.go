@@ -0,0 +1,166 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("handleDelete", func() {
+	var (
+		r        *CommonServiceReconciler
+		existing []byte
+	)
+	licensingService := func() []byte {
+		services, _, _ := unstructured.NestedSlice(getTestOperandConfig(r, "common-service").Object, "spec", "services")
+		service, err := json.Marshal(getItemByName(services, "ibm-licensing-operator"))
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return service
+	}
+
+	BeforeEach(func() {
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+  - name: ibm-licensing-operator
+    spec:
+      IBMLicensing:
+        resources:
+          limits:
+            cpu: 500m
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+- name: ibm-licensing-operator
+  spec:
+    IBMLicensing:
+      resources:
+        limits:
+          cpu: "2"
+`)), tenant)
+		existing = licensingService()
+	})
+
+	It("should only shrink the operators of the deleted CR", func() {
+		// The deleted CR only sized the mongodb operator
+		deleted := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		Expect(r.handleDelete(context.TODO(), deleted)).To(Succeed())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(1))
+		Expect(string(licensingService())).To(Equal(string(existing)))
+	})
+
+	It("should shrink all the operators without the deleted CR", func() {
+		Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(1))
+		Expect(string(licensingService())).NotTo(Equal(string(existing)))
+	})
+})
+
+var _ = Describe("handleDelete of a dominated CR", func() {
+	var (
+		r     *CommonServiceReconciler
+		lists int
+		// The deleted CR only requested the sizing dominated by the peer
+		dominated *apiv3.CommonService
+	)
+
+	BeforeEach(func() {
+		peer := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+        resources:
+          limits:
+            cpu: "1"
+`)
+		dominated = newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+        resources:
+          limits:
+            cpu: 500m
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: "1"
+`)), peer)
+		// Count the lists of the CommonService CRs
+		lists = 0
+		c := newHookClient(r)
+		c.list = func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+			if _, ok := list.(*apiv3.CommonServiceList); ok {
+				lists++
+			}
+			return c.Client.List(ctx, list, opts...)
+		}
+	})
+
+	It("should skip the recompute", func() {
+		resourceVersion := getTestOperandConfig(r, "common-service").GetResourceVersion()
+		Expect(r.handleDelete(context.TODO(), dominated)).To(Succeed())
+		Expect(lists).To(Equal(0))
+		Expect(getTestOperandConfig(r, "common-service").GetResourceVersion()).To(Equal(resourceVersion))
+	})
+
+	It("should recompute the CR which could have set the largest value", func() {
+		matching := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		Expect(r.handleDelete(context.TODO(), matching)).To(Succeed())
+		Expect(lists).To(Equal(1))
+	})
+
+	It("should recompute the CR handing the operator off to a non-default profile controller", func() {
+		dominated.Spec.ProfileController = "turbo"
+		Expect(r.handleDelete(context.TODO(), dominated)).To(Succeed())
+		Expect(lists).To(Equal(1))
+	})
+})
@@ -0,0 +1,89 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// The services of the OperandConfig are a list of the operators, e.g.
+// "- name: ibm-im-mongodb-operator\n  spec: ...", while the newer and the
+// transformed OperandConfigs may key them by the operator name instead, e.g.
+// "ibm-im-mongodb-operator:\n  spec: ...". The merge works on the list, the
+// map-shaped services are converted into it when read and back when written.
+
+// isMapShapedServices reports whether the OperandConfig keys its services by
+// the operator name
+func isMapShapedServices(opcon *unstructured.Unstructured) bool {
+	services, _, _ := unstructured.NestedFieldNoCopy(opcon.Object, "spec", "services")
+	_, ok := services.(map[string]interface{})
+	return ok
+}
+
+// servicesMapToSlice converts the map-shaped services into the list sorted by
+// the name, the key of each operator is its name
+func servicesMapToSlice(services map[string]interface{}) ([]interface{}, error) {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	servicesSlice := make([]interface{}, 0, len(services))
+	for _, name := range names {
+		service, ok := services[name].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the service %s is not an object", name)
+		}
+		// The nested values are shared with the map, like the items of the
+		// list-shaped services are shared with the OperandConfig
+		item := make(map[string]interface{}, len(service)+1)
+		for key, value := range service {
+			item[key] = value
+		}
+		item["name"] = name
+		servicesSlice = append(servicesSlice, item)
+	}
+	return servicesSlice, nil
+}
+
+// servicesSliceToMap converts the list of the services back into the map keyed
+// by the name, the name is dropped from the values. The items without a name
+// can't be keyed and are left out.
+func servicesSliceToMap(services []interface{}) map[string]interface{} {
+	servicesMap := make(map[string]interface{}, len(services))
+	for _, service := range services {
+		serviceMap, ok := service.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := serviceMap["name"].(string)
+		if name == "" {
+			continue
+		}
+		value := make(map[string]interface{}, len(serviceMap)-1)
+		for key, field := range serviceMap {
+			if key != "name" {
+				value[key] = field
+			}
+		}
+		servicesMap[name] = value
+	}
+	return servicesMap
+}
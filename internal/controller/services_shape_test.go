@@ -0,0 +1,106 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/mohae/deepcopy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("updateOperandConfig with the map-shaped services", func() {
+	var (
+		opconServices []interface{}
+		sliceShaped   *unstructured.Unstructured
+		mapShaped     *unstructured.Unstructured
+		crConfigs     = `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: $delete
+`
+		mapping = map[string]string{"profileController": "default"}
+	)
+
+	merge := func(opcon *unstructured.Unstructured) *unstructured.Unstructured {
+		cs := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+        resources:
+          limits:
+            cpu: $delete
+`)
+		r := newTestReconciler(opcon, cs)
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(crConfigs), mapping)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return getTestOperandConfig(r, "common-service")
+	}
+
+	BeforeEach(func() {
+		opconServices = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 500m
+          memory: 1Gi
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)
+		sliceShaped = merge(newTestOperandConfig(deepcopy.Copy(opconServices).([]interface{})))
+		opcon := newTestOperandConfig(nil)
+		opcon.Object["spec"] = map[string]interface{}{"services": servicesSliceToMap(deepcopy.Copy(opconServices).([]interface{}))}
+		mapShaped = merge(opcon)
+	})
+
+	It("should write the map-shaped services back as a map", func() {
+		Expect(isMapShapedServices(mapShaped)).To(BeTrue())
+		Expect(isMapShapedServices(sliceShaped)).To(BeFalse())
+		_, hasName, _ := unstructured.NestedFieldNoCopy(mapShaped.Object, "spec", "services", "ibm-test-operator", "name")
+		Expect(hasName).To(BeFalse())
+	})
+
+	It("should merge the same services as the slice-shaped ones", func() {
+		mergedFromMap, err := getOperandConfigServices(mapShaped)
+		Expect(err).NotTo(HaveOccurred())
+		mergedFromSlice, err := getOperandConfigServices(sliceShaped)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mergedFromMap).To(Equal(mergedFromSlice))
+		mongoDB := getTestServiceSpec(sliceShaped, "ibm-im-mongodb-operator", "mongoDB")
+		Expect(mongoDB["replicas"]).To(BeEquivalentTo(3))
+		Expect(mongoDB["resources"].(map[string]interface{})["limits"]).To(Equal(map[string]interface{}{"memory": "1Gi"}))
+	})
+
+	It("should round trip the conversions", func() {
+		services, err := servicesMapToSlice(servicesSliceToMap(opconServices))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(Equal(opconServices))
+	})
+})
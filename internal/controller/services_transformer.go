@@ -0,0 +1,51 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+)
+
+// ServicesTransformer post-processes the merged services of the OperandConfig
+// before they are written, e.g. to reserve the resources of a sidecar. It
+// receives the services merged from all the CommonService CRs and returns the
+// services to write. The transformer changes the services of the operators, it
+// must not add or remove operators.
+//
+// The services passed in belong to the current merge only, so a transformer
+// may modify them and return them, but it must not keep them, or share its
+// own values between the services, across calls. A transformer runs on every
+// merge, including the no-op ones, so it must be idempotent, otherwise the
+// OperandConfig is rewritten on every reconcile.
+type ServicesTransformer func(services []interface{}) []interface{}
+
+// RegisterServicesTransformer appends the transformer to the transformers of
+// the reconciler. The transformers run in the order of their registration,
+// each one on the output of the previous one, after all the merge steps,
+// including the sizing overlay.
+func (r *CommonServiceReconciler) RegisterServicesTransformer(transformer ServicesTransformer) {
+	r.Transformers = append(r.Transformers, transformer)
+}
+
+// transformServices runs the registered transformers on the merged services
+func (r *CommonServiceReconciler) transformServices(logger logr.Logger, opconServices []interface{}) []interface{} {
+	for i, transformer := range r.Transformers {
+		logger.V(2).Info("Transforming the merged services", "transformer", i)
+		opconServices = transformer(opconServices)
+	}
+	return opconServices
+}
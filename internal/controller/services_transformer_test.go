@@ -0,0 +1,76 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("updateOperandConfig with the services transformers", func() {
+	var r *CommonServiceReconciler
+
+	BeforeEach(func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`))
+		cs := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		r = newTestReconciler(opcon, cs)
+	})
+
+	It("should run the transformers in the order of their registration", func() {
+		var order []string
+		r.RegisterServicesTransformer(func(services []interface{}) []interface{} {
+			order = append(order, "label")
+			for _, service := range services {
+				spec := service.(map[string]interface{})["spec"].(map[string]interface{})
+				for _, crSpec := range spec {
+					Expect(unstructured.SetNestedField(crSpec.(map[string]interface{}), "reserved", "labels", "example.com/sidecar")).To(Succeed())
+				}
+			}
+			return services
+		})
+		r.RegisterServicesTransformer(func(services []interface{}) []interface{} {
+			order = append(order, "check")
+			label, _, _ := unstructured.NestedString(services[0].(map[string]interface{}), "spec", "mongoDB", "labels", "example.com/sidecar")
+			Expect(label).To(Equal("reserved"))
+			return services
+		})
+
+		_, err := r.updateOperandConfig(context.TODO(), nil, map[string]string{"profileController": "default"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(order).To(Equal([]string{"label", "check"}))
+
+		By("writing the transformed services into the OperandConfig")
+		mongoDB := getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")
+		Expect(mongoDB["replicas"]).To(BeEquivalentTo(3))
+		label, _, _ := unstructured.NestedString(mongoDB, "labels", "example.com/sidecar")
+		Expect(label).To(Equal("reserved"))
+	})
+})
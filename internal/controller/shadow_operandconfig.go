@@ -0,0 +1,147 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	"github.com/mohae/deepcopy"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+const (
+	ShadowOpconSuffix     = "-shadow"
+	ShadowApprovedAnnoKey = "commonservices.operator.ibm.com/shadow-approved"
+	ShadowApprovedValue   = "true"
+)
+
+// updateShadowOperandConfig writes the merged services into the shadow
+// OperandConfig for inspection instead of the live one. When the shadow
+// OperandConfig has been approved, the services it carries are promoted into
+// the live OperandConfig first, and the approval is consumed.
+func (r *CommonServiceReconciler) updateShadowOperandConfig(ctx context.Context, opcon *unstructured.Unstructured, services []interface{}) error {
+	shadow := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	shadowKey := types.NamespacedName{
+		Name:      opcon.GetName() + ShadowOpconSuffix,
+		Namespace: opcon.GetNamespace(),
+	}
+	if err := r.Reader.Get(ctx, shadowKey, shadow); err != nil {
+		if !errors.IsNotFound(err) {
+			klog.Errorf("failed to get shadow OperandConfig %s: %v", shadowKey.String(), err)
+			return err
+		}
+		shadow.SetName(shadowKey.Name)
+		shadow.SetNamespace(shadowKey.Namespace)
+		shadow.Object["spec"] = map[string]interface{}{
+			"services": services,
+		}
+		klog.Infof("Creating shadow OperandConfig %s, waiting for approval to promote it", shadowKey.String())
+		if err := r.Client.Create(ctx, shadow); err != nil {
+			klog.Errorf("failed to create shadow OperandConfig %s: %v", shadowKey.String(), err)
+			return err
+		}
+		return nil
+	}
+
+	if shadow.GetAnnotations()[ShadowApprovedAnnoKey] == ShadowApprovedValue {
+		if err := r.promoteShadowOperandConfig(ctx, opcon, shadow); err != nil {
+			return err
+		}
+		annotations := shadow.GetAnnotations()
+		delete(annotations, ShadowApprovedAnnoKey)
+		shadow.SetAnnotations(annotations)
+	}
+
+	if shadow.Object["spec"] == nil {
+		shadow.Object["spec"] = map[string]interface{}{}
+	}
+	shadow.Object["spec"].(map[string]interface{})["services"] = services
+	if err := r.Update(ctx, shadow); err != nil {
+		klog.Errorf("failed to update shadow OperandConfig %s: %v", shadowKey.String(), err)
+		return err
+	}
+	return nil
+}
+
+// promoteShadowOperandConfig copies the services of the approved shadow
+// OperandConfig into the live OperandConfig
+func (r *CommonServiceReconciler) promoteShadowOperandConfig(ctx context.Context, opcon, shadow *unstructured.Unstructured) error {
+	shadowServices, _, err := unstructured.NestedFieldNoCopy(shadow.Object, "spec", "services")
+	if err != nil || shadowServices == nil {
+		klog.Warningf("Skipping promotion of shadow OperandConfig %s/%s, because it has no services", shadow.GetNamespace(), shadow.GetName())
+		return nil
+	}
+
+	live := opcon.DeepCopy()
+	if live.Object["spec"] == nil {
+		live.Object["spec"] = map[string]interface{}{}
+	}
+	if shadowSlice, ok := shadowServices.([]interface{}); ok {
+		// The live OperandConfig keeps the shape of its services
+		setOperandConfigServices(live, deepcopy.Copy(shadowSlice).([]interface{}))
+	} else {
+		live.Object["spec"].(map[string]interface{})["services"] = deepcopy.Copy(shadowServices)
+	}
+
+	klog.Infof("Promoting approved shadow OperandConfig %s/%s into OperandConfig %s/%s", shadow.GetNamespace(), shadow.GetName(), opcon.GetNamespace(), opcon.GetName())
+	if err := r.Update(ctx, live); err != nil {
+		klog.Errorf("failed to promote shadow OperandConfig into OperandConfig %s/%s: %v", opcon.GetNamespace(), opcon.GetName(), err)
+		return err
+	}
+	return nil
+}
+
+// mappingToDriftRequestForShadowOperandConfig enqueues the merge of the
+// OperandConfig when its shadow OperandConfig is edited, so the approval is
+// promoted without waiting for the next CommonService change
+func (r *CommonServiceReconciler) mappingToDriftRequestForShadowOperandConfig() handler.MapFunc {
+	return func(object client.Object) []reconcile.Request {
+		operandConfig, ok := object.(*odlm.OperandConfig)
+		if !ok {
+			// It's not an OperandConfig, ignore
+			return nil
+		}
+		if operandConfig.Name != r.Bootstrap.CSData.OperandConfigName+ShadowOpconSuffix || operandConfig.Namespace != r.Bootstrap.CSData.ServicesNs {
+			return nil
+		}
+		return []reconcile.Request{
+			{NamespacedName: types.NamespacedName{Name: operandConfigDriftPrefix + r.Bootstrap.CSData.OperandConfigName, Namespace: operandConfig.Namespace}},
+		}
+	}
+}
+
+// shadowOperandConfigPredicate passes the updates of the shadow OperandConfig
+// changing its annotations, e.g. the approval
+func shadowOperandConfigPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return false },
+		UpdateFunc:  predicate.AnnotationChangedPredicate{}.Update,
+		DeleteFunc:  func(e event.DeleteEvent) bool { return false },
+		GenericFunc: func(e event.GenericEvent) bool { return false },
+	}
+}
@@ -0,0 +1,169 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	odlm "github.com/IBM/operand-deployment-lifecycle-manager/v4/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+var _ = Describe("Shadow OperandConfig", func() {
+	var (
+		r       *CommonServiceReconciler
+		mapping = map[string]string{"profileController": "default"}
+	)
+
+	BeforeEach(func() {
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`)), tenant)
+		r.Bootstrap.CSData.ShadowMergeEnable = true
+	})
+
+	It("should write the merged sizing to the shadow OperandConfig until it is approved", func() {
+		newConfigs := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+`
+
+		By("merging the new sizing into the shadow OperandConfig only")
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(1))
+		shadow := getTestOperandConfig(r, "common-service"+ShadowOpconSuffix)
+		Expect(getTestServiceSpec(shadow, "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(3))
+
+		By("promoting the approved shadow OperandConfig on the next merge")
+		shadow.SetAnnotations(map[string]string{ShadowApprovedAnnoKey: ShadowApprovedValue})
+		Expect(r.Update(context.TODO(), shadow)).To(Succeed())
+		_, err = r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(newConfigs), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(3))
+		Expect(getTestOperandConfig(r, "common-service"+ShadowOpconSuffix).GetAnnotations()).NotTo(HaveKey(ShadowApprovedAnnoKey))
+	})
+
+	It("should write the shrunk sizing of a deleted CR to the shadow OperandConfig", func() {
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+`)
+		deleted := newTestCommonServiceObject("tenant-b", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 3
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+`)), tenant)
+		r.Bootstrap.CSData.ShadowMergeEnable = true
+
+		Expect(r.handleDelete(context.TODO(), deleted)).To(Succeed())
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(3))
+		shadow := getTestOperandConfig(r, "common-service"+ShadowOpconSuffix)
+		Expect(getTestServiceSpec(shadow, "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(1))
+	})
+
+	It("should not write the shadow OperandConfig when the merge changes nothing", func() {
+		tenant := newTestCommonServiceObject("tenant-a", "example-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: 1
+`)
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`)), tenant)
+		r.Bootstrap.CSData.ShadowMergeEnable = true
+
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		shadow := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+		err = r.Client.Get(context.TODO(), types.NamespacedName{Name: "common-service" + ShadowOpconSuffix, Namespace: testServicesNs}, shadow)
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should only pass the annotation changes of the shadow OperandConfig", func() {
+		old := &odlm.OperandConfig{ObjectMeta: metav1.ObjectMeta{Name: "common-service" + ShadowOpconSuffix, Namespace: testServicesNs}}
+		approved := old.DeepCopy()
+		approved.SetAnnotations(map[string]string{ShadowApprovedAnnoKey: ShadowApprovedValue})
+		resized := old.DeepCopy()
+		resized.SetGeneration(2)
+
+		Expect(shadowOperandConfigPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: approved})).To(BeTrue())
+		Expect(shadowOperandConfigPredicate().Update(event.UpdateEvent{ObjectOld: old, ObjectNew: resized})).To(BeFalse())
+		Expect(shadowOperandConfigPredicate().Create(event.CreateEvent{Object: approved})).To(BeFalse())
+	})
+
+	It("should promote the approved shadow OperandConfig through the drift request of the live one", func() {
+		Expect(r.ReconcileAll(context.TODO())).To(Succeed())
+		shadow := getTestOperandConfig(r, "common-service"+ShadowOpconSuffix)
+
+		By("mapping the shadow OperandConfig to the drift request of the live one")
+		requests := r.mappingToDriftRequestForShadowOperandConfig()(&odlm.OperandConfig{ObjectMeta: metav1.ObjectMeta{Name: "common-service" + ShadowOpconSuffix, Namespace: testServicesNs}})
+		Expect(requests).To(HaveLen(1))
+		Expect(isOperandConfigDriftRequest(requests[0])).To(BeTrue())
+		Expect(r.mappingToDriftRequestForShadowOperandConfig()(&odlm.OperandConfig{ObjectMeta: metav1.ObjectMeta{Name: "common-service", Namespace: testServicesNs}})).To(BeEmpty())
+
+		By("promoting the approved shadow OperandConfig in the merge")
+		shadow.SetAnnotations(map[string]string{ShadowApprovedAnnoKey: ShadowApprovedValue})
+		Expect(r.Update(context.TODO(), shadow)).To(Succeed())
+		result, err := r.Reconcile(context.TODO(), requests[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+		Expect(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB")["replicas"]).To(BeEquivalentTo(3))
+		Expect(getTestOperandConfig(r, "common-service"+ShadowOpconSuffix).GetAnnotations()).NotTo(HaveKey(ShadowApprovedAnnoKey))
+	})
+})
@@ -0,0 +1,155 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"strconv"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog"
+)
+
+const (
+	// MinAvailableRuleKey declares the minAvailable replicas of a CR in the
+	// rules, e.g. "minAvailable: {mongoDB: 2}"
+	MinAvailableRuleKey = "minAvailable"
+	// PDBRuleKey declares the PodDisruptionBudget in the OperandConfig
+	// namespace guarding a CR, e.g. "podDisruptionBudgets: {mongoDB: icp-mongodb}"
+	PDBRuleKey = "podDisruptionBudgets"
+)
+
+// clampShrinkToMinAvailable keeps the replicas shrunk from the existing
+// services from dropping below the minAvailable of the operand, the replicas
+// are clamped up to minAvailable but never above the existing replicas
+func (r *CommonServiceReconciler) clampShrinkToMinAvailable(ctx context.Context, existingServices, opconServices, ruleSlice []interface{}) []interface{} {
+	for _, opService := range opconServices {
+		opServiceMap, ok := opService.(map[string]interface{})
+		if !ok {
+			klog.Warningf("Skipping clamping the replicas of operator %v, because it is not an object", opService)
+			continue
+		}
+		name, ok := opServiceMap["name"].(string)
+		if !ok || name == "" {
+			klog.Warningf("Skipping clamping the replicas of operator %v, because its name is not a string", opServiceMap["name"])
+			continue
+		}
+		rules := getItemByName(ruleSlice, name)
+		existingService, _ := getItemByName(existingServices, name).(map[string]interface{})
+		if rules == nil || existingService == nil {
+			continue
+		}
+		opSpec, ok := opServiceMap["spec"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		existingSpecs, ok := existingService["spec"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for cr, spec := range opSpec {
+			specMap, ok := spec.(map[string]interface{})
+			if !ok {
+				klog.Warningf("Skipping clamping the replicas of %s in %s, because it is not an object", cr, name)
+				continue
+			}
+			existingSpec, ok := existingSpecs[cr].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			replicas, ok := replicasToInt(specMap["replicas"])
+			if !ok {
+				continue
+			}
+			existingReplicas, ok := replicasToInt(existingSpec["replicas"])
+			if !ok || replicas >= existingReplicas {
+				continue
+			}
+			minAvailable := r.getMinAvailable(ctx, rules, cr)
+			if replicas >= minAvailable {
+				continue
+			}
+			clamped := minAvailable
+			if clamped > existingReplicas {
+				clamped = existingReplicas
+			}
+			klog.Infof("Clamping replicas of %s in %s from %d up to %d, because minAvailable is %d", cr, name, replicas, clamped, minAvailable)
+			specMap["replicas"] = clamped
+		}
+	}
+	return opconServices
+}
+
+// getMinAvailable returns the larger minAvailable of a CR between the one
+// declared in the rules and the one of the PodDisruptionBudget from the rules
+func (r *CommonServiceReconciler) getMinAvailable(ctx context.Context, rules interface{}, cr string) int64 {
+	var minAvailable int64
+	if declared, ok := childRule(rules, MinAvailableRuleKey).(map[string]interface{}); ok {
+		if value, ok := replicasToInt(declared[cr]); ok {
+			minAvailable = value
+		} else if declared[cr] != nil {
+			klog.Warningf("Skipping %s %v of %s, because it is not a number", MinAvailableRuleKey, declared[cr], cr)
+		}
+	}
+
+	pdbs, ok := childRule(rules, PDBRuleKey).(map[string]interface{})
+	if !ok {
+		return minAvailable
+	}
+	pdbName, ok := pdbs[cr].(string)
+	if !ok || pdbName == "" {
+		return minAvailable
+	}
+	pdb := &policyv1.PodDisruptionBudget{}
+	pdbKey := types.NamespacedName{Name: pdbName, Namespace: r.Bootstrap.CSData.ServicesNs}
+	if err := r.Reader.Get(ctx, pdbKey, pdb); err != nil {
+		if !errors.IsNotFound(err) {
+			klog.Errorf("failed to get PodDisruptionBudget %s: %v", pdbKey.String(), err)
+		}
+		return minAvailable
+	}
+	if pdb.Spec.MinAvailable == nil {
+		return minAvailable
+	}
+	if pdb.Spec.MinAvailable.Type != intstr.Int {
+		klog.Warningf("Skipping PodDisruptionBudget %s, because minAvailable %s is not an integer", pdbKey.String(), pdb.Spec.MinAvailable.String())
+		return minAvailable
+	}
+	if value := int64(pdb.Spec.MinAvailable.IntVal); value > minAvailable {
+		minAvailable = value
+	}
+	return minAvailable
+}
+
+// replicasToInt converts the replicas from the unstructured services
+func replicasToInt(replicas interface{}) (int64, bool) {
+	switch replicas := replicas.(type) {
+	case int64:
+		return replicas, true
+	case int:
+		return int64(replicas), true
+	case float64:
+		return int64(replicas), true
+	case string:
+		value, err := strconv.ParseInt(replicas, 10, 64)
+		return value, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,90 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("clampShrinkToMinAvailable", func() {
+	It("should not shrink the replicas below the minAvailable", func() {
+		minAvailable := intstr.FromInt(2)
+		pdb := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-b-pdb", Namespace: testServicesNs},
+			Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: &minAvailable},
+		}
+		r := newTestReconciler(pdb)
+
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  minAvailable:
+    testA: 3
+- name: ibm-test-b-operator
+  podDisruptionBudgets:
+    testB: test-b-pdb
+- name: ibm-test-c-operator
+  minAvailable:
+    testC: 1
+`)
+		existing := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 5
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: 3
+- name: ibm-test-c-operator
+  spec:
+    testC:
+      replicas: 3
+`)
+		shrunk := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 1
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: 1
+- name: ibm-test-c-operator
+  spec:
+    testC:
+      replicas: 1
+`)
+
+		services := r.clampShrinkToMinAvailable(context.TODO(), existing, shrunk, ruleSlice)
+
+		replicas := func(operator, cr string) interface{} {
+			return getItemByName(services, operator).(map[string]interface{})["spec"].(map[string]interface{})[cr].(map[string]interface{})["replicas"]
+		}
+		// Clamped up to the minAvailable declared in the rules
+		Expect(replicas("ibm-test-a-operator", "testA")).To(BeEquivalentTo(3))
+		// Clamped up to the minAvailable of the PodDisruptionBudget
+		Expect(replicas("ibm-test-b-operator", "testB")).To(BeEquivalentTo(2))
+		// The shrink doesn't violate minAvailable
+		Expect(replicas("ibm-test-c-operator", "testC")).To(BeEquivalentTo(1))
+	})
+})
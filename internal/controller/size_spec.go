@@ -0,0 +1,266 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ServiceSizeSpec is the well-known portion of a service in the size spec of a
+// CommonService CR. The CR keys it doesn't know are kept in the generic maps.
+type ServiceSizeSpec struct {
+	Name               string
+	ManagementStrategy string
+	Profile            string
+	// CRs are the sizes of the CRs in the spec of the service, keyed by the CR
+	CRs map[string]CRSizeSpec
+}
+
+// CRSizeSpec is the size of a CR in the spec of a service
+type CRSizeSpec struct {
+	Replicas  *int64
+	Resources ResourcesSizeSpec
+	// Unknown holds the other keys of the CR, they are merged as they are
+	Unknown map[string]interface{}
+}
+
+// ResourcesSizeSpec is the resources block of a CR
+type ResourcesSizeSpec struct {
+	Limits   QuantitiesSizeSpec
+	Requests QuantitiesSizeSpec
+}
+
+// QuantitiesSizeSpec is the cpu and memory of the limits or requests, the
+// other quantities are kept in the generic map
+type QuantitiesSizeSpec struct {
+	CPU    *resource.Quantity
+	Memory *resource.Quantity
+}
+
+// SizeSpecTypeError is returned when a well-known key of the size spec has a
+// value of the wrong type
+type SizeSpecTypeError struct {
+	// Path is the path of the key, e.g. spec.services[0].spec.mongoDB.replicas
+	Path     string
+	Expected string
+	Value    interface{}
+}
+
+func (e *SizeSpecTypeError) Error() string {
+	return fmt.Sprintf("invalid size spec: %s must be %s, got %T %v", e.Path, e.Expected, e.Value, e.Value)
+}
+
+// decodeSizeSpec decodes the services of the size spec of a CommonService CR,
+// in the same order, returning a SizeSpecTypeError for the first value of the
+// wrong type
+func decodeSizeSpec(services interface{}) ([]ServiceSizeSpec, error) {
+	if services == nil {
+		return nil, nil
+	}
+	servicesSlice, ok := services.([]interface{})
+	if !ok {
+		return nil, &SizeSpecTypeError{Path: "spec.services", Expected: "a list", Value: services}
+	}
+	sizeSpecs := make([]ServiceSizeSpec, 0, len(servicesSlice))
+	for i, service := range servicesSlice {
+		sizeSpec, err := decodeServiceSizeSpec(fmt.Sprintf("spec.services[%d]", i), service)
+		if err != nil {
+			return nil, err
+		}
+		sizeSpecs = append(sizeSpecs, sizeSpec)
+	}
+	return sizeSpecs, nil
+}
+
+func decodeServiceSizeSpec(path string, service interface{}) (ServiceSizeSpec, error) {
+	var sizeSpec ServiceSizeSpec
+	serviceMap, ok := service.(map[string]interface{})
+	if !ok {
+		return sizeSpec, &SizeSpecTypeError{Path: path, Expected: "an object", Value: service}
+	}
+	name, ok := serviceMap["name"].(string)
+	if !ok || name == "" {
+		return sizeSpec, &SizeSpecTypeError{Path: path + ".name", Expected: "a non-empty string", Value: serviceMap["name"]}
+	}
+	sizeSpec.Name = name
+	var err error
+	if sizeSpec.ManagementStrategy, err = decodeOptionalString(path+".managementStrategy", serviceMap["managementStrategy"]); err != nil {
+		return sizeSpec, err
+	}
+	if sizeSpec.Profile, err = decodeOptionalString(path+"."+PinnedProfileKey, serviceMap[PinnedProfileKey]); err != nil {
+		return sizeSpec, err
+	}
+	if serviceMap["spec"] == nil {
+		return sizeSpec, nil
+	}
+	spec, ok := serviceMap["spec"].(map[string]interface{})
+	if !ok {
+		return sizeSpec, &SizeSpecTypeError{Path: path + ".spec", Expected: "an object", Value: serviceMap["spec"]}
+	}
+	sizeSpec.CRs = make(map[string]CRSizeSpec, len(spec))
+	for cr, crSpec := range spec {
+		crSizeSpec, err := decodeCRSizeSpec(path+".spec."+cr, crSpec)
+		if err != nil {
+			return sizeSpec, err
+		}
+		sizeSpec.CRs[cr] = crSizeSpec
+	}
+	return sizeSpec, nil
+}
+
+func decodeCRSizeSpec(path string, crSpec interface{}) (CRSizeSpec, error) {
+	var sizeSpec CRSizeSpec
+	crMap, ok := crSpec.(map[string]interface{})
+	if !ok {
+		return sizeSpec, &SizeSpecTypeError{Path: path, Expected: "an object", Value: crSpec}
+	}
+	for key, value := range crMap {
+		switch key {
+		case "replicas":
+			replicas, err := decodeReplicas(path+".replicas", value)
+			if err != nil {
+				return sizeSpec, err
+			}
+			sizeSpec.Replicas = replicas
+		case "resources":
+			resources, err := decodeResourcesSizeSpec(path+".resources", value)
+			if err != nil {
+				return sizeSpec, err
+			}
+			sizeSpec.Resources = resources
+		default:
+			if sizeSpec.Unknown == nil {
+				sizeSpec.Unknown = map[string]interface{}{}
+			}
+			sizeSpec.Unknown[key] = value
+		}
+	}
+	return sizeSpec, nil
+}
+
+func decodeResourcesSizeSpec(path string, resources interface{}) (ResourcesSizeSpec, error) {
+	var sizeSpec ResourcesSizeSpec
+	if resources == nil {
+		return sizeSpec, nil
+	}
+	resourcesMap, ok := resources.(map[string]interface{})
+	if !ok {
+		return sizeSpec, &SizeSpecTypeError{Path: path, Expected: "an object", Value: resources}
+	}
+	var err error
+	if sizeSpec.Limits, err = decodeQuantitiesSizeSpec(path+".limits", resourcesMap["limits"]); err != nil {
+		return sizeSpec, err
+	}
+	if sizeSpec.Requests, err = decodeQuantitiesSizeSpec(path+".requests", resourcesMap["requests"]); err != nil {
+		return sizeSpec, err
+	}
+	return sizeSpec, nil
+}
+
+func decodeQuantitiesSizeSpec(path string, quantities interface{}) (QuantitiesSizeSpec, error) {
+	var sizeSpec QuantitiesSizeSpec
+	if quantities == nil {
+		return sizeSpec, nil
+	}
+	quantitiesMap, ok := quantities.(map[string]interface{})
+	if !ok {
+		return sizeSpec, &SizeSpecTypeError{Path: path, Expected: "an object", Value: quantities}
+	}
+	var err error
+	if sizeSpec.CPU, err = decodeQuantity(path+".cpu", quantitiesMap["cpu"]); err != nil {
+		return sizeSpec, err
+	}
+	if sizeSpec.Memory, err = decodeQuantity(path+".memory", quantitiesMap["memory"]); err != nil {
+		return sizeSpec, err
+	}
+	return sizeSpec, nil
+}
+
+// decodeQuantity decodes a quantity, either a string like 500m or a number,
+// it is nil for the delete marker
+func decodeQuantity(path string, value interface{}) (*resource.Quantity, error) {
+	var quantityStr string
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if value == DeleteMarker {
+			return nil, nil
+		}
+		quantityStr = value
+	case int, int32, int64, float64:
+		quantityStr = fmt.Sprintf("%v", value)
+	default:
+		return nil, &SizeSpecTypeError{Path: path, Expected: "a quantity", Value: value}
+	}
+	quantity, err := resource.ParseQuantity(quantityStr)
+	if err != nil {
+		return nil, &SizeSpecTypeError{Path: path, Expected: "a quantity", Value: value}
+	}
+	return &quantity, nil
+}
+
+// decodeReplicas decodes a non-negative whole number, a numeric string is
+// accepted too
+func decodeReplicas(path string, value interface{}) (*int64, error) {
+	var replicas int64
+	switch value := value.(type) {
+	case nil:
+		return nil, nil
+	case int:
+		replicas = int64(value)
+	case int32:
+		replicas = int64(value)
+	case int64:
+		replicas = value
+	case float64:
+		if value != math.Trunc(value) {
+			return nil, &SizeSpecTypeError{Path: path, Expected: "a whole number", Value: value}
+		}
+		replicas = int64(value)
+	case string:
+		if value == DeleteMarker {
+			return nil, nil
+		}
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, &SizeSpecTypeError{Path: path, Expected: "a whole number", Value: value}
+		}
+		replicas = parsed
+	default:
+		return nil, &SizeSpecTypeError{Path: path, Expected: "a whole number", Value: value}
+	}
+	if replicas < 0 {
+		return nil, &SizeSpecTypeError{Path: path, Expected: "a non-negative number", Value: value}
+	}
+	return &replicas, nil
+}
+
+func decodeOptionalString(path string, value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", &SizeSpecTypeError{Path: path, Expected: "a string", Value: value}
+	}
+	return str, nil
+}
@@ -0,0 +1,110 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+var _ = Describe("decodeSizeSpec", func() {
+	It("should decode the sizing of the services", func() {
+		sizeSpecs, err := decodeSizeSpec(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  managementStrategy: turbo
+  profile: small
+  spec:
+    mongoDB:
+      replicas: 3
+      storageClass: fast
+      resources:
+        limits:
+          cpu: 500m
+          memory: 1Gi
+          ephemeral-storage: 2Gi
+        requests:
+          cpu: 1
+- name: ibm-licensing-operator
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sizeSpecs).To(HaveLen(2))
+		mongodb := sizeSpecs[0]
+		Expect(mongodb.Name).To(Equal("ibm-im-mongodb-operator"))
+		Expect(mongodb.ManagementStrategy).To(Equal("turbo"))
+		Expect(mongodb.Profile).To(Equal("small"))
+		crSize := mongodb.CRs["mongoDB"]
+		Expect(*crSize.Replicas).To(BeEquivalentTo(3))
+		Expect(crSize.Resources.Limits.CPU.String()).To(Equal("500m"))
+		Expect(crSize.Resources.Limits.Memory.String()).To(Equal("1Gi"))
+		Expect(crSize.Resources.Requests.CPU.String()).To(Equal("1"))
+		Expect(crSize.Resources.Requests.Memory).To(BeNil())
+		Expect(crSize.Unknown).To(Equal(map[string]interface{}{"storageClass": "fast"}))
+		Expect(sizeSpecs[1].Name).To(Equal("ibm-licensing-operator"))
+		Expect(sizeSpecs[1].CRs).To(BeNil())
+	})
+
+	It("should decode no services to an empty spec", func() {
+		sizeSpecs, err := decodeSizeSpec(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sizeSpecs).To(BeEmpty())
+	})
+
+	DescribeTable("should report the path of the mistyped field",
+		func(spec, path string) {
+			var services interface{}
+			Expect(json.Unmarshal([]byte(spec), &services)).To(Succeed())
+			_, err := decodeSizeSpec(services)
+			var typeErr *SizeSpecTypeError
+			Expect(errors.As(err, &typeErr)).To(BeTrue())
+			Expect(typeErr.Path).To(Equal(path))
+		},
+		Entry("services not a list", `{"name": "a"}`, "spec.services"),
+		Entry("missing name", `[{"spec": {}}]`, "spec.services[0].name"),
+		Entry("numeric managementStrategy", `[{"name": "a", "managementStrategy": 1}]`, "spec.services[0].managementStrategy"),
+		Entry("spec not an object", `[{"name": "a", "spec": []}]`, "spec.services[0].spec"),
+		Entry("CR not an object", `[{"name": "a"}, {"name": "b", "spec": {"cr": "oops"}}]`, "spec.services[1].spec.cr"),
+		Entry("string replicas", `[{"name": "a", "spec": {"cr": {"replicas": "three"}}}]`, "spec.services[0].spec.cr.replicas"),
+		Entry("fractional replicas", `[{"name": "a", "spec": {"cr": {"replicas": 1.5}}}]`, "spec.services[0].spec.cr.replicas"),
+		Entry("invalid cpu quantity", `[{"name": "a", "spec": {"cr": {"resources": {"limits": {"cpu": "lots"}}}}}]`, "spec.services[0].spec.cr.resources.limits.cpu"),
+		Entry("boolean memory", `[{"name": "a", "spec": {"cr": {"resources": {"requests": {"memory": true}}}}}]`, "spec.services[0].spec.cr.resources.requests.memory"),
+		Entry("limits not an object", `[{"name": "a", "spec": {"cr": {"resources": {"limits": "1"}}}}]`, "spec.services[0].spec.cr.resources.limits"),
+	)
+
+	It("should fail to render the configs of a CR of an invalid spec", func() {
+		cs := newTestCommonServiceObject(testServicesNs, "common-service", `
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        replicas: three
+`)
+		r := newTestReconciler(cs)
+		csUnstructured := util.NewUnstructured("operator.ibm.com", "CommonService", "v3")
+		Expect(r.Client.Get(context.TODO(), types.NamespacedName{Namespace: testServicesNs, Name: "common-service"}, csUnstructured)).To(Succeed())
+		_, _, err := r.getNewConfigs(csUnstructured)
+		var typeErr *SizeSpecTypeError
+		Expect(errors.As(err, &typeErr)).To(BeTrue())
+	})
+})
@@ -0,0 +1,133 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// UnknownSizeKeyReason is the reason of the event recorded for the unknown
+// keys in the size spec of a CommonService CR
+const UnknownSizeKeyReason = "UnknownSizeKey"
+
+var (
+	// resourcesKeys are the keys recognized in a resources block
+	resourcesKeys = map[string]bool{
+		"limits":   true,
+		"requests": true,
+	}
+	// resourcesClaimsKey is the key of the resource claims in a resources
+	// block, the claims hold no quantities and are not validated
+	resourcesClaimsKey = "claims"
+	// resourceQuantityKeys are the keys recognized in the limits and requests
+	// of a resources block, besides the hugepages-<size> ones and the ones
+	// holding a quantity, e.g. nvidia.com/gpu
+	resourceQuantityKeys = map[string]bool{
+		"cpu":               true,
+		"memory":            true,
+		"storage":           true,
+		"ephemeral-storage": true,
+	}
+)
+
+// validateSizeKeys walks the services of a CommonService CR and returns the
+// paths of the keys in the resources blocks which are not recognized, e.g. a
+// misspelled "request" or a "memmory" without a quantity would never take
+// effect
+func validateSizeKeys(services []interface{}) []string {
+	var unknownKeys []string
+	for _, service := range services {
+		serviceMap, ok := service.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := serviceMap["name"].(string)
+		for _, key := range []string{"spec", "resources"} {
+			if serviceMap[key] != nil {
+				unknownKeys = findUnknownSizeKeys(name+"."+key, serviceMap[key], unknownKeys)
+			}
+		}
+	}
+	sort.Strings(unknownKeys)
+	return unknownKeys
+}
+
+func findUnknownSizeKeys(path string, value interface{}, unknownKeys []string) []string {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, child := range value {
+			if resources, ok := child.(map[string]interface{}); ok && key == "resources" {
+				unknownKeys = findUnknownResourcesKeys(path+"."+key, resources, unknownKeys)
+				continue
+			}
+			unknownKeys = findUnknownSizeKeys(path+"."+key, child, unknownKeys)
+		}
+	case []interface{}:
+		for _, child := range value {
+			unknownKeys = findUnknownSizeKeys(path, child, unknownKeys)
+		}
+	}
+	return unknownKeys
+}
+
+func findUnknownResourcesKeys(path string, resources map[string]interface{}, unknownKeys []string) []string {
+	for key, quantities := range resources {
+		if key == resourcesClaimsKey {
+			continue
+		}
+		if !resourcesKeys[key] {
+			unknownKeys = append(unknownKeys, path+"."+key)
+			continue
+		}
+		quantitiesMap, ok := quantities.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for quantityKey, quantity := range quantitiesMap {
+			if !isKnownQuantityKey(quantityKey, quantity) {
+				unknownKeys = append(unknownKeys, path+"."+key+"."+quantityKey)
+			}
+		}
+	}
+	return unknownKeys
+}
+
+// isKnownQuantityKey checks if the key under the limits or requests is merged
+// as a quantity, by the same rule as isQuantityLeaf
+func isKnownQuantityKey(key string, value interface{}) bool {
+	if resourceQuantityKeys[key] || strings.HasPrefix(key, corev1.ResourceHugePagesPrefix) {
+		return true
+	}
+	return !nonQuantityKeys[key] && isQuantityValue(value)
+}
+
+// warnUnknownSizeKeys warns about the unknown keys in the size spec of the
+// CommonService CR, before it is merged into the OperandConfig
+func (r *CommonServiceReconciler) warnUnknownSizeKeys(cs *unstructured.Unstructured) {
+	services, _, _ := unstructured.NestedSlice(cs.Object, "spec", "services")
+	unknownKeys := validateSizeKeys(services)
+	if len(unknownKeys) == 0 {
+		return
+	}
+	klog.Warningf("CommonService %s/%s has unknown keys in the size spec, they are ignored: %s", cs.GetNamespace(), cs.GetName(), strings.Join(unknownKeys, ", "))
+	r.Recorder.Eventf(cs, corev1.EventTypeWarning, UnknownSizeKeyReason, "Unknown keys in the size spec are ignored: %s", strings.Join(unknownKeys, ", "))
+}
@@ -0,0 +1,105 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("validateSizeKeys", func() {
+	valid := `
+- name: ibm-im-operator
+  spec:
+    authentication:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 1000m
+          memory: 1Gi
+          ephemeral-storage: 2Gi
+          hugepages-2Mi: 100Mi
+          nvidia.com/gpu: 1
+          example.com/foo: 500m
+        requests:
+          cpu: 100m
+        claims:
+        - name: gpu
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            memory: 512Mi
+`
+	misspelled := `
+- name: ibm-im-operator
+  spec:
+    authentication:
+      replicas: 2
+      resources:
+        limits:
+          cpu: 1000m
+          memmory: 1 Gi
+          version: "1.2"
+        request:
+          cpu: 100m
+  resources:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: test-deployment
+    data:
+      spec:
+        resources:
+          limits:
+            memmory: lots
+`
+
+	It("should accept the known size keys", func() {
+		Expect(validateSizeKeys(mustConvertStringToSlice(valid))).To(BeEmpty())
+	})
+
+	It("should list the unknown size keys", func() {
+		Expect(validateSizeKeys(mustConvertStringToSlice(misspelled))).To(Equal([]string{
+			"ibm-im-operator.resources.data.spec.resources.limits.memmory",
+			"ibm-im-operator.spec.authentication.resources.limits.memmory",
+			"ibm-im-operator.spec.authentication.resources.limits.version",
+			"ibm-im-operator.spec.authentication.resources.request",
+		}))
+	})
+
+	It("should report the unknown size keys as a warning event on the CR", func() {
+		r := newTestReconciler()
+		r.warnUnknownSizeKeys(newTestCommonService("common-service", `
+- services:
+`+strings.ReplaceAll(misspelled, "\n", "\n  ")))
+		Expect(r.Recorder.(*record.FakeRecorder).Events).To(HaveLen(1))
+		Expect(<-r.Recorder.(*record.FakeRecorder).Events).To(ContainSubstring("memmory"))
+
+		r = newTestReconciler()
+		r.warnUnknownSizeKeys(newTestCommonService("common-service", `
+- services:
+`+strings.ReplaceAll(valid, "\n", "\n  ")))
+		Expect(r.Recorder.(*record.FakeRecorder).Events).To(BeEmpty())
+	})
+})
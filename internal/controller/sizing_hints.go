@@ -0,0 +1,84 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// SizingHintsAnnoKey carries the sizing hints of the fields not modeled by the
+// CommonService CRD, in the same format as spec.services, e.g.
+// [{"name": "ibm-im-mongodb-operator", "spec": {"mongoDB": {"resources": {"limits": {"cpu": "2"}}}}}]
+const SizingHintsAnnoKey = "commonservices.operator.ibm.com/sizing-hints"
+
+// getSizingHints parses the sizing hints from the annotation of the
+// CommonService CR. Only the fields with rules are kept, so the hints are
+// merged like the spec values.
+func getSizingHints(cs *unstructured.Unstructured, ruleSlice []interface{}) ([]interface{}, error) {
+	hintsStr, ok := cs.GetAnnotations()[SizingHintsAnnoKey]
+	if !ok || hintsStr == "" {
+		return nil, nil
+	}
+
+	var hints []interface{}
+	if err := json.Unmarshal([]byte(hintsStr), &hints); err != nil {
+		klog.Errorf("failed to parse annotation %s of CommonService %s/%s: %v", SizingHintsAnnoKey, cs.GetNamespace(), cs.GetName(), err)
+		return nil, fmt.Errorf("failed to parse annotation %s: %v", SizingHintsAnnoKey, err)
+	}
+
+	var hintConfigs []interface{}
+	for _, hint := range hints {
+		hintMap, ok := hint.(map[string]interface{})
+		if !ok {
+			klog.Warningf("Skipping sizing hint %v, because it is not an object", hint)
+			continue
+		}
+		name, _ := hintMap["name"].(string)
+		hintSpec, _ := hintMap["spec"].(map[string]interface{})
+		rulesSpec, _ := childRule(getItemByName(ruleSlice, name), "spec").(map[string]interface{})
+		if name == "" || hintSpec == nil || rulesSpec == nil {
+			klog.Warningf("Skipping sizing hint for %s, because it has no spec or no rules", name)
+			continue
+		}
+		for cr, spec := range hintSpec {
+			specMap, ok := spec.(map[string]interface{})
+			if !ok {
+				klog.Warningf("Skipping sizing hint for %s in %s, because it is not an object", cr, name)
+				delete(hintSpec, cr)
+				continue
+			}
+			rulesForCR, ok := rulesSpec[cr].(map[string]interface{})
+			if !ok {
+				klog.Warningf("Skipping sizing hint for %s in %s, because it has no rules", cr, name)
+				delete(hintSpec, cr)
+				continue
+			}
+			for key := range specMap {
+				filterChangedMapWithRules(defaultMergeLogger(), name+".spec."+cr, key, specMap[key], rulesForCR[key], specMap, 1)
+			}
+		}
+		hintConfigs = append(hintConfigs, map[string]interface{}{
+			"name": name,
+			"spec": hintSpec,
+		})
+	}
+	return hintConfigs, nil
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("Sizing hints", func() {
+	It("should merge the hints with rules of a CommonService", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+`)
+		template := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      resources:
+        limits:
+          cpu: 100m
+`)
+		hinted := newTestCommonService("example-service", `
+- size: as-is
+`)
+		hinted.SetAnnotations(map[string]string{
+			SizingHintsAnnoKey: `[{"name": "ibm-test-a-operator", "spec": {"testA": {"debug": true, "resources": {"limits": {"cpu": "1"}}}}}]`,
+		})
+		crs := []*unstructured.Unstructured{
+			newTestCommonService("common-service", `
+- services:
+  - name: ibm-test-a-operator
+    spec:
+      testA:
+        resources:
+          limits:
+            cpu: 200m
+`),
+			hinted,
+		}
+
+		// The field without rules is dropped from the hints
+		hints, err := getSizingHints(hinted, ruleSlice)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hints).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      resources:
+        limits:
+          cpu: "1"
+`)))
+
+		services, _, err := newTestReconciler().ReconcileFromSnapshot(context.TODO(), template, crs, ruleSlice)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The cpu hint is larger than the cpu in spec
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      resources:
+        limits:
+          cpu: "1"
+`)))
+	})
+})
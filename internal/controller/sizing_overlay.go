@@ -0,0 +1,116 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/mohae/deepcopy"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// SizingOverlayDataKey is the key of the ConfigMap data holding the sizing
+// overlay, in the same format as spec.services, e.g.
+// "- name: ibm-im-mongodb-operator\n  spec:\n    mongoDB:\n      resources: ..."
+const SizingOverlayDataKey = "services"
+
+// getSizingOverlay reads the sizing overlay from the ConfigMap in the
+// OperandConfig namespace. There is no overlay when the ConfigMap is not
+// configured or not found.
+func (r *CommonServiceReconciler) getSizingOverlay(ctx context.Context, logger logr.Logger) ([]interface{}, error) {
+	name := r.Bootstrap.CSData.SizingOverlayConfigMap
+	if name == "" {
+		return nil, nil
+	}
+	cmKey := types.NamespacedName{Name: name, Namespace: r.Bootstrap.CSData.ServicesNs}
+	cm := &corev1.ConfigMap{}
+	if err := r.Reader.Get(ctx, cmKey, cm); err != nil {
+		if errors.IsNotFound(err) {
+			logger.V(2).Info("Skipping the sizing overlay, because the ConfigMap is not found", "configMap", cmKey.String())
+			return nil, nil
+		}
+		return nil, err
+	}
+	overlayStr, ok := cm.Data[SizingOverlayDataKey]
+	if !ok || overlayStr == "" {
+		return nil, nil
+	}
+	overlay, err := convertStringToSlice(overlayStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the sizing overlay in ConfigMap %s: %w", cmKey.String(), err)
+	}
+	return overlay, nil
+}
+
+// applySizingOverlay merges the overlay on top of the aggregated services. The
+// overlay wins over the sizing of all the CRs for the keys it sets, the other
+// keys keep their aggregated values. Only the CRs already in the OperandConfig
+// are overlaid.
+func applySizingOverlay(logger logr.Logger, opconServices, overlay []interface{}) []interface{} {
+	for _, overlayService := range overlay {
+		overlayMap, ok := overlayService.(map[string]interface{})
+		if !ok {
+			logger.Info("Skipping the sizing overlay, because it is not an object", "overlay", overlayService)
+			continue
+		}
+		overlaySpec, ok := overlayMap["spec"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		opService, ok := getItemByIdentity(opconServices, overlayService).(map[string]interface{})
+		if !ok {
+			logger.Info("Skipping the sizing overlay, because the operator is not in the OperandConfig", "operator", overlayMap["name"])
+			continue
+		}
+		opSpec, ok := opService["spec"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for cr, spec := range overlaySpec {
+			specMap, ok := spec.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			opSpecForCR, ok := opSpec[cr].(map[string]interface{})
+			if !ok {
+				logger.Info("Skipping the sizing overlay, because the CR is not in the OperandConfig", "operator", overlayMap["name"], "cr", cr)
+				continue
+			}
+			logger.V(2).Info("Applying the sizing overlay", "operator", overlayMap["name"], "cr", cr)
+			opSpec[cr] = mergeSizeProfile(opSpecForCR, deepcopy.Copy(specMap).(map[string]interface{}))
+		}
+	}
+	return opconServices
+}
+
+// overlaySizing applies the sizing overlay configured for the OperandConfig
+// as the last step of the merge
+func (r *CommonServiceReconciler) overlaySizing(ctx context.Context, logger logr.Logger, opconServices []interface{}) ([]interface{}, error) {
+	overlay, err := r.getSizingOverlay(ctx, logger)
+	if err != nil {
+		logger.Error(err, "Failed to get the sizing overlay")
+		return nil, err
+	}
+	if overlay == nil {
+		return opconServices, nil
+	}
+	return applySizingOverlay(logger, opconServices, overlay), nil
+}
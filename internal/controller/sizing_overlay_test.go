@@ -0,0 +1,107 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("updateOperandConfig with a sizing overlay", func() {
+	var (
+		r       *CommonServiceReconciler
+		mapping = map[string]string{"profileController": "default"}
+	)
+
+	BeforeEach(func() {
+		opcon := newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: "1"
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`))
+		overlay := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "sizing-overlay", Namespace: testServicesNs},
+			Data: map[string]string{SizingOverlayDataKey: `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 4Gi
+- name: ibm-missing-operator
+  spec:
+    missingCR:
+      replicas: 2
+`},
+		}
+		r = newTestReconciler(opcon, overlay)
+		r.Bootstrap.CSData.SizingOverlayConfigMap = "sizing-overlay"
+	})
+
+	It("should apply the overlay and keep the values set by the CRs", func() {
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      replicas: 3
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The memory floor is applied though no CR requested it
+		updated := getTestOperandConfig(r, "common-service")
+		mongoDB := getTestServiceSpec(updated, "ibm-im-mongodb-operator", "mongoDB")
+		Expect(mongoDB["replicas"]).To(BeEquivalentTo(3))
+		limits, _, _ := unstructured.NestedMap(mongoDB, "resources", "limits")
+		Expect(limits).To(Equal(map[string]interface{}{"cpu": "1", "memory": "4Gi"}))
+		Expect(getTestServiceSpec(updated, "ibm-test-operator", "testCR")["replicas"]).To(BeEquivalentTo(2))
+		// The operators missing from the OperandConfig aren't added
+		services, _, _ := unstructured.NestedSlice(updated.Object, "spec", "services")
+		Expect(getItemByName(services, "ibm-missing-operator")).To(BeNil())
+	})
+
+	It("should let the overlay win over the CRs and survive the shrink on deletion", func() {
+		_, err := r.updateOperandConfig(context.TODO(), mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          memory: 8Gi
+`), mapping)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.handleDelete(context.TODO(), nil)).To(Succeed())
+		memory, _, _ := unstructured.NestedString(getTestServiceSpec(getTestOperandConfig(r, "common-service"), "ibm-im-mongodb-operator", "mongoDB"), "resources", "limits", "memory")
+		Expect(memory).To(Equal("4Gi"))
+	})
+})
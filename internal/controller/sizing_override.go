@@ -0,0 +1,99 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/IBM/ibm-common-service-operator/v4/internal/controller/rules"
+)
+
+// SizingOverriddenReason is the reason of the event recorded on a
+// CommonService CR when its requested sizing is superseded by a larger peer
+const SizingOverriddenReason = "SizingOverridden"
+
+// sizingOverrideKeys are the keys compared for the sizing overrides
+var sizingOverrideKeys = map[string]bool{
+	"cpu":    true,
+	"memory": true,
+}
+
+// recordSizingOverrides records an event on each CommonService CR whose
+// requested cpu or memory is superseded by the cluster-wide maximum in the
+// merged services
+func (r *CommonServiceReconciler) recordSizingOverrides(csList []unstructured.Unstructured, requestedConfigsList [][]interface{}, opconServices []interface{}) {
+	for i := range csList {
+		for _, service := range requestedConfigsList[i] {
+			serviceMap, ok := service.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := serviceMap["name"].(string)
+			requestedSpec, ok := serviceMap["spec"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			opService, ok := getItemByIdentity(opconServices, service).(map[string]interface{})
+			if !ok {
+				continue
+			}
+			mergedSpec, ok := opService["spec"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, override := range findSizingOverrides("", requestedSpec, mergedSpec, nil) {
+				r.Recorder.Eventf(&csList[i], corev1.EventTypeNormal, SizingOverriddenReason, "The requested %s of %s is superseded by the cluster-wide maximum", override, name)
+			}
+		}
+	}
+}
+
+// findSizingOverrides returns the cpu and memory of the requested spec which
+// are smaller than the merged ones, e.g. "mongoDB.resources.limits.cpu 100m -> 1000m"
+func findSizingOverrides(path string, requested, merged map[string]interface{}, overrides []string) []string {
+	var keys []string
+	for key := range requested {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		keyPath := key
+		if path != "" {
+			keyPath = path + "." + key
+		}
+		switch requestedValue := requested[key].(type) {
+		case map[string]interface{}:
+			if mergedValue, ok := merged[key].(map[string]interface{}); ok {
+				overrides = findSizingOverrides(keyPath, requestedValue, mergedValue, overrides)
+			}
+		case string:
+			mergedValue, ok := merged[key].(string)
+			// The equal values written differently, e.g. 1 and 1000m, are not
+			// overridden
+			if !ok || !sizingOverrideKeys[key] || rules.ResourceEqualComparison(requestedValue, mergedValue) {
+				continue
+			}
+			if large, _ := rules.ResourceComparison(mergedValue, requestedValue); large == mergedValue {
+				overrides = append(overrides, keyPath+" "+requestedValue+" -> "+mergedValue)
+			}
+		}
+	}
+	return overrides
+}
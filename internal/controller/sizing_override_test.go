@@ -0,0 +1,99 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+var _ = Describe("getExtremeizes sizing overrides", func() {
+	var ruleSlice []interface{}
+	opconServices := `
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          cpu: 100m
+          memory: 256Mi
+`
+	newCR := func(namespace, cpu string) *apiv3.CommonService {
+		return newTestCommonServiceObject(namespace, "example-service", fmt.Sprintf(`
+- services:
+  - name: ibm-im-mongodb-operator
+    spec:
+      mongoDB:
+        resources:
+          limits:
+            cpu: "%s"
+            memory: 256Mi
+`, cpu))
+	}
+	// mergeEvents returns the events recorded by the merge of the CRs
+	mergeEvents := func(extreme Extreme, crs ...*apiv3.CommonService) []string {
+		var objs []client.Object
+		for _, cr := range crs {
+			objs = append(objs, cr)
+		}
+		r := newTestReconciler(objs...)
+		_, err := r.getExtremeizes(context.TODO(), mustConvertStringToSlice(opconServices), ruleSlice, extreme)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		var events []string
+		for len(r.Recorder.(*record.FakeRecorder).Events) > 0 {
+			events = append(events, <-r.Recorder.(*record.FakeRecorder).Events)
+		}
+		return events
+	}
+
+	BeforeEach(func() {
+		ruleSlice = mustConvertStringToSlice(`
+- name: ibm-im-mongodb-operator
+  spec:
+    mongoDB:
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+	})
+
+	It("should record the smaller request superseded by the larger peer", func() {
+		Expect(mergeEvents(Max, newCR("tenant-a", "200m"), newCR("tenant-b", "1"))).To(Equal([]string{
+			"Normal SizingOverridden The requested mongoDB.resources.limits.cpu 200m -> 1 of ibm-im-mongodb-operator is superseded by the cluster-wide maximum",
+		}))
+	})
+
+	It("should not record an override when the peers request the same size", func() {
+		Expect(mergeEvents(Max, newCR("tenant-a", "1000m"), newCR("tenant-b", "1"))).To(BeEmpty())
+	})
+
+	It("should not record an override for a single CR", func() {
+		Expect(mergeEvents(Max, newCR("tenant-a", "200m"))).To(BeEmpty())
+	})
+
+	It("should not record an override when shrinking", func() {
+		Expect(mergeEvents(Min, newCR("tenant-a", "200m"), newCR("tenant-b", "1"))).To(BeEmpty())
+	})
+})
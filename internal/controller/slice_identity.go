@@ -0,0 +1,74 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import "reflect"
+
+// sliceIdentityKeys are the keys identifying the items of a slice, the items
+// with the same identity are merged regardless of their positions
+var sliceIdentityKeys = []string{"name", "id"}
+
+// getSliceItemIdentity returns the identity key and value of the slice item
+func getSliceItemIdentity(item interface{}) (string, interface{}, bool) {
+	itemMap, ok := item.(map[string]interface{})
+	if !ok {
+		return "", nil, false
+	}
+	for _, key := range sliceIdentityKeys {
+		if value, ok := itemMap[key]; ok && value != nil {
+			return key, value, true
+		}
+	}
+	return "", nil, false
+}
+
+// matchSliceItems returns the index of the changed item merged with each
+// default item, or -1 when there is none. The items with an identity are
+// matched by it, and the others fall back to the same index.
+func matchSliceItems(defaultItems, changedItems []interface{}) []int {
+	matches := make([]int, len(defaultItems))
+	used := make([]bool, len(changedItems))
+	for i, defaultItem := range defaultItems {
+		matches[i] = -1
+		key, value, ok := getSliceItemIdentity(defaultItem)
+		if !ok {
+			continue
+		}
+		for j, changedItem := range changedItems {
+			if used[j] {
+				continue
+			}
+			if changedMap, ok := changedItem.(map[string]interface{}); ok && reflect.DeepEqual(changedMap[key], value) {
+				matches[i] = j
+				used[j] = true
+				break
+			}
+		}
+	}
+	for i, defaultItem := range defaultItems {
+		if _, _, ok := getSliceItemIdentity(defaultItem); ok || i >= len(changedItems) || used[i] {
+			continue
+		}
+		// The changed item with an identity belongs to another default item
+		if _, _, ok := getSliceItemIdentity(changedItems[i]); ok {
+			continue
+		}
+		matches[i] = i
+		used[i] = true
+	}
+	return matches
+}
@@ -0,0 +1,82 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("merging reordered arrays", func() {
+	var defaultSpec, changedSpec map[string]interface{}
+
+	cpuAndMemory := func(containers []interface{}) map[string][]interface{} {
+		result := map[string][]interface{}{}
+		for _, container := range containers {
+			containerMap := container.(map[string]interface{})
+			result[containerMap["name"].(string)] = []interface{}{containerMap["cpu"], containerMap["memory"]}
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		defaultSpec = mustConvertStringToSlice(`
+- containers:
+  - name: a
+    cpu: 500m
+    memory: 1Gi
+  - name: b
+    cpu: "2"
+    memory: 256Mi
+`)[0].(map[string]interface{})
+		changedSpec = mustConvertStringToSlice(`
+- containers:
+  - name: b
+    cpu: "1"
+  - name: a
+    cpu: 800m
+`)[0].(map[string]interface{})
+	})
+
+	It("should merge the same-name items regardless of their positions", func() {
+		merged := mergeCRsIntoOperandConfigWithDefaultRules(logr.Discard(), defaultSpec, changedSpec, false)
+		Expect(cpuAndMemory(merged["containers"].([]interface{}))).To(Equal(map[string][]interface{}{
+			"a": {"800m", "1Gi"},
+			"b": {"2", "256Mi"},
+		}))
+	})
+
+	It("should match the items the same way in the deep merge of the size profiles", func() {
+		merged := mergeSizeProfile(defaultSpec, changedSpec)
+		Expect(cpuAndMemory(merged["containers"].([]interface{}))).To(Equal(map[string][]interface{}{
+			"a": {"800m", "1Gi"},
+			"b": {"1", "256Mi"},
+		}))
+	})
+
+	It("should fall back to the index for the items without a name or id", func() {
+		Expect(matchSliceItems(mustConvertStringToSlice(`
+- id: x
+- {}
+- name: c
+`), mustConvertStringToSlice(`
+- id: x
+- {}
+`))).To(Equal([]int{0, 1, -1}))
+	})
+})
@@ -0,0 +1,117 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/mohae/deepcopy"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog"
+
+	apiv3 "github.com/IBM/ibm-common-service-operator/v4/api/v3"
+)
+
+// ReconcileFromSnapshot runs the OperandConfig merge pipeline in memory. The
+// template is the services of the OperandConfig, the first CR of crs is the
+// one being reconciled, and all of them are summarized by the largest size.
+// When ruleSlice is nil, the ConfigurationRules are used. It returns the
+// merged services and the change log against the template, the template
+// itself is not modified.
+func (r *CommonServiceReconciler) ReconcileFromSnapshot(ctx context.Context, template []interface{}, crs []*unstructured.Unstructured, ruleSlice []interface{}) ([]interface{}, []string, error) {
+	if ruleSlice == nil {
+		var err error
+		if ruleSlice, err = getConfigurationRules(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	logger := r.mergeLogger()
+	opconServices := deepcopy.Copy(template).([]interface{})
+	if len(crs) == 0 {
+		return opconServices, nil, nil
+	}
+
+	// The terminating CR is already removed from the aggregate
+	var nullPaths [][]string
+	if crs[0].GetDeletionTimestamp() == nil {
+		newConfigs, serviceControllerMapping, err := r.buildNewConfigsFromSnapshot(crs[0], ruleSlice)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The isolated operators are sized by the master CR only
+		if !r.checkNamespace(crs[0].GetNamespace() + "/" + crs[0].GetName()) {
+			_, newConfigs = splitIsolatedOperators(newConfigs, getIsolatedOperators(ruleSlice))
+		}
+		nullPaths = collectNullPaths(newConfigs, r.Bootstrap.CSData.NullDeleteEnable)
+		opconServices, err = mergeNewConfigs(ctx, logger, opconServices, newConfigs, ruleSlice, serviceControllerMapping, r.CSData.ServicesNs, r.CSData.MergeWorkers, false)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var activeCRs []unstructured.Unstructured
+	var csConfigsList [][]interface{}
+	var masterConfigs []interface{}
+	var deletedPaths [][]string
+	serviceControllerMappingSummary := make(map[string]string)
+	for _, cs := range crs {
+		if cs.GetDeletionTimestamp() != nil {
+			continue
+		}
+		csConfigs, serviceControllerMapping, err := r.buildNewConfigsFromSnapshot(cs, ruleSlice)
+		if err != nil {
+			return nil, nil, err
+		}
+		deletedPaths = append(deletedPaths, collectNullPaths(csConfigs, false)...)
+		if r.checkNamespace(cs.GetNamespace()+"/"+cs.GetName()) && csConfigs != nil {
+			masterConfigs = deepcopy.Copy(csConfigs).([]interface{})
+		}
+		serviceControllerMappingSummary = mergeProfileController(serviceControllerMappingSummary, serviceControllerMapping)
+		activeCRs = append(activeCRs, *cs)
+		csConfigsList = append(csConfigsList, csConfigs)
+	}
+	if len(activeCRs) > 0 {
+		var err error
+		opconServices, _, err = r.mergeActiveConfigs(ctx, logger.WithValues("extreme", Max), opconServices, activeCRs, csConfigsList, masterConfigs, serviceControllerMappingSummary, deletedPaths, ruleSlice, Max, r.CSData.ServicesNs)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	opconServices = deleteNullPaths(opconServices, nullPaths)
+	// The overlay of the platform wins over the sizing of all the CRs
+	opconServices, err := r.overlaySizing(ctx, logger, opconServices)
+	if err != nil {
+		return nil, nil, err
+	}
+	opconServices = r.transformServices(logger, opconServices)
+
+	return opconServices, serviceChangeStrings(diffOperandConfigServices(template, opconServices)), nil
+}
+
+// buildNewConfigsFromSnapshot renders the configs from a copy of the CR, the
+// merge pipeline modifies the configs in place
+func (r *CommonServiceReconciler) buildNewConfigsFromSnapshot(cs *unstructured.Unstructured, ruleSlice []interface{}) ([]interface{}, map[string]string, error) {
+	cs = cs.DeepCopy()
+	csObject := &apiv3.CommonService{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(cs.Object, csObject); err != nil {
+		klog.Errorf("failed to convert CommonService %s/%s: %v", cs.GetNamespace(), cs.GetName(), err)
+		return nil, nil, err
+	}
+	return r.buildNewConfigs(cs, csObject, ruleSlice)
+}
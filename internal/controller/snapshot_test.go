@@ -0,0 +1,316 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/mohae/deepcopy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("ReconcileFromSnapshot", func() {
+	It("should merge the CRs into the template and report the changes", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: LARGEST_VALUE
+      profile: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: LARGEST_VALUE
+      profile: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+          memory: LARGEST_VALUE
+`)
+		template := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 1
+      profile: small
+      resources:
+        limits:
+          cpu: 100m
+          memory: 256Mi
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: 1
+      profile: small
+      resources:
+        limits:
+          cpu: 100m
+          memory: 256Mi
+- name: ibm-test-c-operator
+  spec:
+    testC:
+      replicas: 1
+`)
+		crs := []*unstructured.Unstructured{
+			newTestCommonService("common-service", `
+- services:
+  - name: ibm-test-a-operator
+    spec:
+      testA:
+        replicas: 2
+        profile: medium
+        resources:
+          limits:
+            cpu: 500m
+  - name: ibm-test-b-operator
+    spec:
+      testB:
+        replicas: 2
+        profile: medium
+  - name: ibm-test-c-operator
+    spec:
+      testC:
+        replicas: 2
+`),
+			newTestCommonService("example-service", `
+- services:
+  - name: ibm-test-a-operator
+    spec:
+      testA:
+        replicas: 3
+        profile: large
+        resources:
+          limits:
+            cpu: 200m
+            memory: 1Gi
+  - name: ibm-test-b-operator
+    managementStrategy: turbo
+    spec:
+      testB:
+        replicas: 3
+        profile: large
+`),
+		}
+		templateCopy := deepcopy.Copy(template)
+
+		services, changes, err := newTestReconciler().ReconcileFromSnapshot(context.TODO(), template, crs, ruleSlice)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(template).To(Equal(templateCopy))
+		// The replicas and resources of ibm-test-b-operator are left to the turbo controller
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 3
+      profile: large
+      resources:
+        limits:
+          cpu: 500m
+          memory: 1Gi
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      profile: large
+      resources:
+        limits: {}
+- name: ibm-test-c-operator
+  spec:
+    testC:
+      replicas: 2
+`)))
+		Expect(changes).To(Equal([]string{
+			"changed ibm-test-a-operator.spec.testA.profile: small -> large",
+			"changed ibm-test-a-operator.spec.testA.replicas: 1 -> 3",
+			"changed ibm-test-a-operator.spec.testA.resources.limits.cpu: 100m -> 500m",
+			"changed ibm-test-a-operator.spec.testA.resources.limits.memory: 256Mi -> 1Gi",
+			"changed ibm-test-b-operator.spec.testB.profile: small -> large",
+			"removed ibm-test-b-operator.spec.testB.replicas: 1",
+			"removed ibm-test-b-operator.spec.testB.resources.limits.cpu: 100m",
+			"removed ibm-test-b-operator.spec.testB.resources.limits.memory: 256Mi",
+			"changed ibm-test-c-operator.spec.testC.replicas: 1 -> 2",
+		}))
+	})
+
+	It("should run the merge steps of the controller", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  isolated: true
+  spec:
+    testA:
+      replicas: LARGEST_VALUE
+- name: ibm-test-b-operator
+  maxReplicas: 3
+  spec:
+    testB:
+      replicas: LARGEST_VALUE
+`)
+		template := mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  spec:
+    testA:
+      replicas: 1
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 100m
+`)
+		crs := []*unstructured.Unstructured{
+			newTestCommonService("example-service", `
+- services:
+  - name: ibm-test-a-operator
+    spec:
+      testA:
+        replicas: 5
+  - name: ibm-test-b-operator
+    spec:
+      testB:
+        replicas: 6
+`),
+			newTestCommonService("common-service", `
+- services:
+  - name: ibm-test-a-operator
+    spec:
+      testA:
+        replicas: 2
+  - name: ibm-test-b-operator
+    spec:
+      testB:
+        replicas: 1
+`),
+		}
+		overlay := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "sizing-overlay", Namespace: testServicesNs},
+			Data: map[string]string{SizingOverlayDataKey: `
+- name: ibm-test-b-operator
+  spec:
+    testB:
+      resources:
+        limits:
+          memory: 4Gi
+`},
+		}
+		r := newTestReconciler(overlay)
+		r.Bootstrap.CSData.SizingOverlayConfigMap = "sizing-overlay"
+		r.RegisterServicesTransformer(func(services []interface{}) []interface{} {
+			for _, service := range services {
+				service.(map[string]interface{})["transformed"] = true
+			}
+			return services
+		})
+
+		// The isolated operator mirrors the master CR, the replicas are clamped,
+		// then the overlay and the transformers are applied like in the controller
+		services, _, err := r.ReconcileFromSnapshot(context.TODO(), template, crs, ruleSlice)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(normalizeTestServices(services)).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-a-operator
+  transformed: true
+  spec:
+    testA:
+      replicas: 2
+- name: ibm-test-b-operator
+  transformed: true
+  spec:
+    testB:
+      replicas: 3
+      resources:
+        limits:
+          cpu: 100m
+          memory: 4Gi
+`)))
+	})
+
+	It("should match the renamed operator by its identity", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+`)
+		// The operator is renamed on upgrade, and keeps the old name as its identity
+		template := mustConvertStringToSlice(`
+- name: ibm-test-operator-v2
+  identity: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 100m
+`)
+		crs := []*unstructured.Unstructured{
+			newTestCommonService("common-service", `
+- services:
+  - name: ibm-test-operator
+    spec:
+      testCR:
+        resources:
+          limits:
+            cpu: 500m
+`),
+			newTestCommonService("example-service", `
+- services:
+  - name: ibm-test-operator-v2
+    identity: ibm-test-operator
+    spec:
+      testCR:
+        resources:
+          limits:
+            cpu: 200m
+`),
+		}
+
+		services, _, err := newTestReconciler().ReconcileFromSnapshot(context.TODO(), template, crs, ruleSlice)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-operator-v2
+  identity: ibm-test-operator
+  spec:
+    testCR:
+      resources:
+        limits:
+          cpu: 500m
+`)))
+	})
+})
+
+var _ = Describe("serviceChangeStrings", func() {
+	It("should skip the malformed services", func() {
+		existing := []interface{}{
+			"ibm-test-operator",
+			map[string]interface{}{"spec": map[string]interface{}{}},
+			map[string]interface{}{"name": "ibm-test-operator", "spec": map[string]interface{}{"replicas": int64(1)}},
+		}
+		updated := []interface{}{
+			map[string]interface{}{"name": int64(1)},
+			map[string]interface{}{"name": "ibm-test-operator", "spec": map[string]interface{}{"replicas": int64(2)}},
+		}
+		Expect(serviceChangeStrings(diffOperandConfigServices(existing, updated))).To(Equal([]string{"changed ibm-test-operator.spec.replicas: 1 -> 2"}))
+	})
+})
@@ -0,0 +1,88 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+)
+
+// summableKeys are the keys summed across the CommonService CRs in the Sum
+// extreme, the other keys keep the largest size
+var summableKeys = map[string]bool{
+	"replicas":  true,
+	"instances": true,
+}
+
+// sumCSConfigs summarizes the configs of all the CommonService CRs by the sum
+// of the replicas and instances in spec, so the stateless operands scale with
+// the number of tenants. The other values keep the largest size.
+func sumCSConfigs(logger logr.Logger, csConfigsList [][]interface{}, ruleSlice []interface{}, serviceControllerMappingSummary map[string]string, opconNs string) []interface{} {
+	return reduceCSConfigs(logger, csConfigsList, ruleSlice, serviceControllerMappingSummary, opconNs, sumLeaves)
+}
+
+// sumLeaves replaces the summable leaf values of the summary with the sum of
+// the values set in the specs
+func sumLeaves(summary map[string]interface{}, specs []map[string]interface{}) {
+	for key, value := range summary {
+		if valueMap, ok := value.(map[string]interface{}); ok {
+			var subSpecs []map[string]interface{}
+			for _, spec := range specs {
+				if subSpec, ok := spec[key].(map[string]interface{}); ok {
+					subSpecs = append(subSpecs, subSpec)
+				}
+			}
+			sumLeaves(valueMap, subSpecs)
+			continue
+		}
+		if !summableKeys[key] {
+			continue
+		}
+		var values []interface{}
+		for _, spec := range specs {
+			if specValue, ok := spec[key]; ok && specValue != nil {
+				values = append(values, specValue)
+			}
+		}
+		if sum, ok := sumValues(values); ok {
+			summary[key] = sum
+		}
+	}
+}
+
+// sumValues returns the sum of the numbers, in the type of the first one
+func sumValues(values []interface{}) (interface{}, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+	var sum int64
+	for _, value := range values {
+		switch value := value.(type) {
+		case int64:
+			sum += value
+		case int:
+			sum += int64(value)
+		case float64:
+			sum += int64(value)
+		default:
+			return nil, false
+		}
+	}
+	if _, ok := values[0].(float64); ok {
+		return float64(sum), true
+	}
+	return sum, true
+}
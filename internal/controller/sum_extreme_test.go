@@ -0,0 +1,74 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sum extreme", func() {
+	It("should sum the replicas while the cpu takes the largest", func() {
+		ruleSlice := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: LARGEST_VALUE
+      resources:
+        limits:
+          cpu: LARGEST_VALUE
+`)
+		csConfig := func(replicas int, cpu string) []interface{} {
+			return mustConvertStringToSlice(fmt.Sprintf(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: %d
+      resources:
+        limits:
+          cpu: %s
+`, replicas, cpu))
+		}
+		opconServices := mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+      resources:
+        limits:
+          cpu: 100m
+`)
+
+		services := mustMergeConfigs(opconServices, [][]interface{}{
+			csConfig(2, "200m"),
+			csConfig(3, "500m"),
+			csConfig(1, "300m"),
+		}, ruleSlice, map[string]string{"profileController": "default"}, Sum, testServicesNs)
+
+		Expect(services).To(Equal(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 6
+      resources:
+        limits:
+          cpu: 500m
+`)))
+	})
+})
@@ -0,0 +1,89 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	util "github.com/IBM/ibm-common-service-operator/v4/internal/controller/common"
+)
+
+const (
+	// VerifyModeLog logs the mismatch found after writing the OperandConfig
+	VerifyModeLog = "log"
+	// VerifyModeError fails the reconcile on the mismatch found after writing
+	// the OperandConfig
+	VerifyModeError = "error"
+)
+
+// verifyOperandConfig re-reads the OperandConfig and confirms the services
+// written match the merged services, guarding against the mutations and
+// defaulting applied by the API server
+func (r *CommonServiceReconciler) verifyOperandConfig(ctx context.Context, opconKey types.NamespacedName, services []interface{}) error {
+	mode := r.Bootstrap.CSData.VerifyAfterWrite
+	if mode != VerifyModeLog && mode != VerifyModeError {
+		return nil
+	}
+
+	opcon := util.NewUnstructured("operator.ibm.com", "OperandConfig", "v1alpha1")
+	if err := r.Reader.Get(ctx, opconKey, opcon); err != nil {
+		klog.Errorf("failed to get OperandConfig %s for verification: %v", opconKey.String(), err)
+		return err
+	}
+	liveServices, err := getOperandConfigServices(opcon)
+	if err != nil {
+		return err
+	}
+
+	// Normalize both sides through JSON, so the number types are comparable
+	expected, err := normalizeServices(services)
+	if err != nil {
+		return err
+	}
+	actual, err := normalizeServices(liveServices)
+	if err != nil {
+		return err
+	}
+	changes := serviceChangeStrings(diffOperandConfigServices(expected, actual))
+	if len(changes) == 0 {
+		return nil
+	}
+
+	klog.Warningf("OperandConfig %s differs from the merged services after writing: %s", opconKey.String(), strings.Join(changes, "; "))
+	if mode == VerifyModeError {
+		return fmt.Errorf("OperandConfig %s differs from the merged services after writing: %s", opconKey.String(), strings.Join(changes, "; "))
+	}
+	return nil
+}
+
+func normalizeServices(services interface{}) ([]interface{}, error) {
+	servicesJSON, err := json.Marshal(services)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal services: %v", err)
+	}
+	var normalized []interface{}
+	if err := json.Unmarshal(servicesJSON, &normalized); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal services: %v", err)
+	}
+	return normalized, nil
+}
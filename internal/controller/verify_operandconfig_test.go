@@ -0,0 +1,90 @@
+//
+// Copyright 2025 IBM Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// mutateOperandConfigOnWrite simulates the API server mutating the
+// OperandConfig on write
+func mutateOperandConfigOnWrite(r *CommonServiceReconciler) {
+	c := newHookClient(r)
+	c.patch = func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+		if !isTestOperandConfig(obj) {
+			return c.Client.Patch(ctx, obj, patch, opts...)
+		}
+		if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+			return err
+		}
+		u := obj.(*unstructured.Unstructured)
+		services, _, _ := unstructured.NestedSlice(u.Object, "spec", "services")
+		for _, service := range services {
+			service.(map[string]interface{})["spec"] = map[string]interface{}{}
+		}
+		_ = unstructured.SetNestedSlice(u.Object, services, "spec", "services")
+		return c.Client.Update(ctx, obj)
+	}
+}
+
+var _ = Describe("Verify after write", func() {
+	var (
+		r          *CommonServiceReconciler
+		newConfigs []interface{}
+		mapping    = map[string]string{"profileController": "default"}
+	)
+
+	BeforeEach(func() {
+		r = newTestReconciler(newTestOperandConfig(mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 1
+`)))
+		newConfigs = mustConvertStringToSlice(`
+- name: ibm-test-operator
+  spec:
+    testCR:
+      replicas: 2
+`)
+	})
+
+	It("should pass when nothing is mutated", func() {
+		r.Bootstrap.CSData.VerifyAfterWrite = VerifyModeError
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should only log the mutation in the log mode", func() {
+		mutateOperandConfigOnWrite(r)
+		r.Bootstrap.CSData.VerifyAfterWrite = VerifyModeLog
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should fail the update on the mutation in the error mode", func() {
+		mutateOperandConfigOnWrite(r)
+		r.Bootstrap.CSData.VerifyAfterWrite = VerifyModeError
+		_, err := r.updateOperandConfig(context.TODO(), newConfigs, mapping)
+		Expect(err).To(MatchError(ContainSubstring("removed ibm-test-operator.spec.testCR.replicas: 2")))
+	})
+})